@@ -15,14 +15,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/create": {
+        "/categories/create": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create news with title, content and categories(optional). Categories must be positive integers, example: [1, 2, 3]",
+                "description": "Create category with name, slug and description(optional). Slug must contain only lowercase letters, digits and hyphens",
                 "consumes": [
                     "application/json"
                 ],
@@ -30,56 +30,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "news"
+                    "categories"
                 ],
-                "summary": "Create news",
+                "summary": "Create category",
                 "parameters": [
                     {
-                        "description": "News data",
+                        "description": "Category data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/service_internal_models.NewsCreateForm"
+                            "$ref": "#/definitions/service_internal_models.CategoryCreateForm"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "News created successful",
+                        "description": "Category created successful",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.SuccessResponseCreate"
+                            "$ref": "#/definitions/internal_handlers_category.SuccessResponseCreate"
                         }
                     },
                     "400": {
                         "description": "Validation error",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "No authorization",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/edit/{id}": {
+        "/categories/edit/{id}": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Edit news fields (title, content, categories). Categories must be positive integers, example: [1, 2, 3]",
+                "description": "Edit category fields (name, slug, description, parent). ParentId moves the category with its subtree, 0 moves it to the root. Moving a category under itself or its descendant is rejected",
                 "consumes": [
                     "application/json"
                 ],
@@ -87,24 +93,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "news"
+                    "categories"
                 ],
-                "summary": "Edit news",
+                "summary": "Edit category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID news",
+                        "description": "ID category",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "News updated data",
+                        "description": "Category updated data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/service_internal_models.NewsEditForm"
+                            "$ref": "#/definitions/service_internal_models.CategoryEditForm"
                         }
                     }
                 ],
@@ -112,37 +118,43 @@ const docTemplate = `{
                     "200": {
                         "description": "Success updated",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.SuccessResponse"
+                            "$ref": "#/definitions/internal_handlers_category.SuccessResponse"
                         }
                     },
                     "400": {
                         "description": "Error validation",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Not authorized",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "News not found",
+                        "description": "Category not found",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/list": {
+        "/categories/list": {
             "get": {
                 "security": [
                     {
@@ -156,9 +168,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "news"
+                    "categories"
                 ],
-                "summary": "Get news",
+                "summary": "Get categories",
                 "parameters": [
                     {
                         "type": "integer",
@@ -175,144 +187,3953 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "List news",
+                        "description": "List categories",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.NewsListsResponse"
+                            "$ref": "#/definitions/internal_handlers_category.CategoryListResponse"
                         }
                     },
                     "400": {
                         "description": "Error validation params",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Not authorized",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "internal_handlers_news.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "Error": {
-                    "type": "string"
-                },
-                "Success": {
-                    "type": "boolean",
-                    "example": false
-                }
-            }
         },
-        "internal_handlers_news.NewsListsResponse": {
-            "type": "object",
-            "properties": {
-                "News": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/service_internal_models.NewsWithCategories"
+        "/categories/tree": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all categories as a tree of nested children",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Get category tree",
+                "responses": {
+                    "200": {
+                        "description": "Category tree",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.CategoryTreeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
                     }
-                },
-                "Success": {
-                    "type": "boolean",
-                    "example": true
-                }
-            }
-        },
-        "internal_handlers_news.SuccessResponse": {
-            "type": "object",
-            "properties": {
-                "Success": {
-                    "type": "boolean",
-                    "example": true
-                }
-            }
-        },
-        "internal_handlers_news.SuccessResponseCreate": {
-            "type": "object",
-            "properties": {
-                "Id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "Success": {
-                    "type": "boolean",
-                    "example": true
                 }
             }
         },
-        "service_internal_models.NewsCreateForm": {
-            "type": "object",
-            "required": [
-                "Content",
-                "Title"
-            ],
-            "properties": {
-                "Categories": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
+        "/categories/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Get category by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID category",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
                     }
-                },
-                "Content": {
-                    "type": "string",
-                    "minLength": 1
-                },
-                "Title": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
                 }
-            }
-        },
-        "service_internal_models.NewsEditForm": {
-            "type": "object",
-            "properties": {
-                "Categories": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete category. Categories with subcategories or assigned to news, including news in the trash, cannot be deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Delete category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID category",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category deleted",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Category has subcategories or is assigned to news",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_category.ErrorResponse"
+                        }
                     }
-                },
-                "Content": {
-                    "type": "string",
-                    "minLength": 1
-                },
-                "Title": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
                 }
             }
         },
-        "service_internal_models.NewsWithCategories": {
-            "type": "object",
-            "properties": {
-                "Categories": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
+        "/categories/{id}/feed.atom": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
                     }
+                ],
+                "description": "Atom feed of the latest published news, of a category and its subcategories when the ID is given.\nSupports conditional requests with If-None-Match. The bearer token is not required when FEED_PUBLIC is set",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "Atom feed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID category",
+                        "name": "id",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Atom feed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Feed not modified"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}/feed.rss": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "RSS 2.0 feed of the latest published news, of a category and its subcategories when the ID is given.\nSupports conditional requests with If-None-Match. The bearer token is not required when FEED_PUBLIC is set",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "RSS feed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID category",
+                        "name": "id",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS feed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Feed not modified"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create news with title, content and categories(optional). Categories must be positive integers, example: [1, 2, 3]\nContentFormat is plain (default), markdown (CommonMark) or html; HTML content is sanitised with an allow-list before it is saved\nNews is created as a draft: PublishAt takes effect once it is submitted for review, as the scheduler only publishes news in review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Create news",
+                "parameters": [
+                    {
+                        "description": "News data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/service_internal_models.NewsCreateForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor recorded in the revision",
+                        "name": "X-Editor",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "News created successful",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SuccessResponseCreate"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "No authorization",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/edit/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Edit news fields (title, content, content format, categories). Categories must be positive integers, example: [1, 2, 3]\nContent of news in the html format is sanitised with an allow-list before it is saved, also when news is switched to html\nPass the version from the ETag header of GET /news/{id} in If-Match or Version to reject the edit when news changed since it was read\nPublishAt or ExpiresAt sent as null removes the scheduled publication or expiry; a field left out is kept. PublishAt of a draft takes effect once it is submitted for review\nWith lang other than the default locale only Title and Content of that translation are edited; a new translation needs both. Translation edits bump the version but record no revision",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Edit news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale of the translation to edit, default is news itself",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "description": "News updated data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/service_internal_models.NewsEditForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor recorded in the revision",
+                        "name": "X-Editor",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expected news version, example: \\",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success updated",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error validation",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "News was modified since the given version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.VersionConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stream all news matching the filters of GET /list in ID order, as NDJSON (one news per line) or CSV with a header row and categories joined with \";\" in one column\nRows are sent as they are read from the database, 100 rows or one second at a time; the export and its database query stop when the client disconnects",
+                "produces": [
+                    "application/x-ndjson",
+                    "text/csv"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Export news",
+                "parameters": [
+                    {
+                        "enum": [
+                            "ndjson",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "description": "ndjson (default) or csv",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category IDs, example: 1,2",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "any (default) - news tagged with any of category, all - with all of them",
+                        "name": "category_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category IDs to exclude",
+                        "name": "exclude_category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also match subcategories of category and exclude_category",
+                        "name": "include_descendants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated statuses (draft, review, published, archived), default=published",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after: date (2025-12-20) or RFC 3339 date-time",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before: date (inclusive) or RFC 3339 date-time",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive substring of the title",
+                        "name": "title_contains",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News export",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Error validation params",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed.atom": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atom feed of the latest published news, of a category and its subcategories when the ID is given.\nSupports conditional requests with If-None-Match. The bearer token is not required when FEED_PUBLIC is set",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "Atom feed",
+                "responses": {
+                    "200": {
+                        "description": "Atom feed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Feed not modified"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed.rss": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "RSS 2.0 feed of the latest published news, of a category and its subcategories when the ID is given.\nSupports conditional requests with If-None-Match. The bearer token is not required when FEED_PUBLIC is set",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "RSS feed",
+                "responses": {
+                    "200": {
+                        "description": "RSS feed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Feed not modified"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_feed.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create news from an NDJSON file (one news per line, fields of POST /create) or a CSV file with a header row, Title and Content columns and optional Categories (IDs joined with \";\"), PublishAt and ExpiresAt; other columns, like the ones of GET /export, are ignored\nThe file is sent as the file field of a multipart form or as the raw body. Every record is validated like in POST /create and news is created in batches of 500, one transaction per batch. The report lists every record by the line it starts on\nWhen the import stops on an error after some batches have been created, the response has the error status and the report of the records read so far, with the error in Error; records that were not created have it in their Error",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Import news",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "NDJSON or CSV file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "ndjson",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "description": "ndjson or csv, default is taken from the file extension or Content-Type, then ndjson",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only validate, create nothing, default=false",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor recorded in the revisions",
+                        "name": "X-Editor",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import report",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unreadable file",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "No authorization",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error, the report is returned when some news has already been created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ImportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get news list. Only published news is returned unless status filter is set\nPages are selected by offset or, for stable paging, by NextCursor/PrevCursor of a previous response passed in cursor\nThe Link header holds first, prev, next and last (offset paging with count=exact only) page URLs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Get news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default=10, max=100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default=0, cannot be combined with cursor",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "NextCursor or PrevCursor from a previous response; the sort defaults to the one of the cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "estimated",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "How to count Total: exact (default) - COUNT(*), estimated - query planner estimate, none - skip",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category IDs, example: 1,2",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "any (default) - news tagged with any of category, all - with all of them",
+                        "name": "category_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category IDs to exclude",
+                        "name": "exclude_category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also match subcategories of category and exclude_category",
+                        "name": "include_descendants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated statuses (draft, review, published, archived), default=published",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after: date (2025-12-20) or RFC 3339 date-time",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before: date (inclusive) or RFC 3339 date-time",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive substring of the title",
+                        "name": "title_contains",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields (id, title, created_at, updated_at, published_at), prefix with - for descending, example: -published_at,title, default=-id",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Add ContentHTML, the content rendered from its ContentFormat as sanitised HTML",
+                        "name": "html",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale of Title and Content; news without a translation falls back to the fallback locales, then the default one. Filters and sort use the default locale",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List news",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.NewsListsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error validation params",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/media": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a JPEG, PNG or GIF image as the file field of a multipart form. The type is checked by the file content, not its name\nThe image is re-encoded without EXIF and other metadata, JPEG images are rotated as their EXIF orientation says. Thumbnails are generated for every configured size\nAttach media to news by listing its ID in Media of POST /create or POST /edit/{id}",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Upload media",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Image",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Media uploaded",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.MediaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing, too large or unsupported file",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "No authorization",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/media/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Media with its size, dimensions and thumbnails. The file is served by GET /media/{id}/file and thumbnails by GET /media/{id}/thumbnails/{size}",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Get media by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID media",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Media",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.MediaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Media not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete media with its files. Media attached to news cannot be deleted",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Delete media",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID media",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Media deleted",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Media not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Media is attached to news",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/media/{id}/file": {
+            "get": {
+                "description": "The uploaded image, re-encoded without metadata. Does not require the bearer token when media is public",
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Media file",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID media",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Image",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Media not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/media/{id}/thumbnails/{size}": {
+            "get": {
+                "description": "Thumbnail that fits a size x size square, one of the Thumbnails sizes of the media. JPEG images have JPEG thumbnails, the rest PNG ones\nDoes not require the bearer token when media is public",
+                "produces": [
+                    "image/jpeg",
+                    "image/png"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Media thumbnail",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID media",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Thumbnail size",
+                        "name": "size",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Thumbnail",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or size",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Media or thumbnail not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_media.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news-sitemap.xml": {
+            "get": {
+                "description": "Google News sitemap of at most 1000 news published in the last two days. Does not require the bearer token",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "sitemaps"
+                ],
+                "summary": "Google News sitemap",
+                "responses": {
+                    "200": {
+                        "description": "News sitemap",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_sitemap.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create up to 500 news in one transaction. Every item is validated like in POST /create and gets a result with its index in the request: the ID of the created news or an error\nInvalid items are skipped unless atomic=true, which creates nothing when any item is invalid; valid items of such a request get the error \"not created: batch rejected (atomic)\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Create news in bulk",
+                "parameters": [
+                    {
+                        "description": "News data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/service_internal_models.NewsCreateForm"
+                            }
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "All or nothing, default=false",
+                        "name": "atomic",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor recorded in the revisions",
+                        "name": "X-Editor",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "All news created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.BulkCreateResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Some news created, see Results",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.BulkCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "No news created, see Results",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.BulkCreateResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "No authorization",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/bulk/edit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Apply one operation to many news in one transaction: add_categories, remove_categories (Categories), replace_category (From with To) or set_status (Status)\nTarget news by Ids in the body, or by the filter query parameters of GET /list. set_status skips news that cannot move to Status. Affected counts the news actually changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Edit news in bulk",
+                "parameters": [
+                    {
+                        "description": "Operation and target news",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/service_internal_models.NewsBulkEditForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category IDs, example: 1,2",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "any (default) - news tagged with any of category, all - with all of them",
+                        "name": "category_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category IDs to exclude",
+                        "name": "exclude_category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also match subcategories of category and exclude_category",
+                        "name": "include_descendants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated statuses (draft, review, published, archived)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after: date (2025-12-20) or RFC 3339 date-time",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before: date (inclusive) or RFC 3339 date-time",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive substring of the title",
+                        "name": "title_contains",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor recorded in the revisions",
+                        "name": "X-Editor",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Operation applied",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.BulkEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "No authorization",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single news item with its categories. The ETag header holds the news version",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Get news by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Add ContentHTML, the content rendered from its ContentFormat as sanitised HTML",
+                        "name": "html",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale of Title and Content; without a translation falls back to the fallback locales, then the default one",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.NewsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move news to the trash. Trashed news is hidden from the list and can be restored until it is purged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Delete news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News moved to trash",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/archive": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move news from published to archived",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflow"
+                ],
+                "summary": "Archive news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status changed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/categories": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach categories to news, keeping the ones it already has. Categories the news already has are skipped, so repeating the request is safe",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Add categories to news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Categories to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/service_internal_models.NewsCategoriesForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor recorded in the revision",
+                        "name": "X-Editor",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News with updated categories",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.NewsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or unknown category",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/categories/{categoryId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Detach a category from news, keeping its other categories. Removing a category the news does not have succeeds without changes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Remove category from news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID category",
+                        "name": "categoryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor recorded in the revision",
+                        "name": "X-Editor",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News with updated categories",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.NewsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/draft": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move news from review, published or archived back to draft",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflow"
+                ],
+                "summary": "Return news to draft",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status changed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move news from review to published",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflow"
+                ],
+                "summary": "Publish news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status changed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/related": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Published news related to news, for a \"read also\" block, best scored first.\nThe score is the weighted sum of the share of categories the news have in common, the trigram similarity of titles and the recency, which halves every configured number of days",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Related news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default=NEWS_RELATED_LIMIT, max=50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Add ContentHTML, the content rendered from its ContentFormat as sanitised HTML",
+                        "name": "html",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale of Title and Content; without a translation falls back to the fallback locales, then the default one",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Related news",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.RelatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or limit",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore news from the trash",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Restore news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News restored",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found in trash",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all revisions of news, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "Get news revisions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List revisions",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.RevisionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/revisions/diff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a line-level diff of the content between two revisions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "Diff news revisions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision to diff from",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision to diff to",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Diff",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.RevisionDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error validation params",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Revision not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/revisions/{rev}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single revision of news",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "Get news revision",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Revision",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.RevisionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Revision not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/revisions/{rev}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Roll news back to a revision. The restored state is saved as a new revision",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "Restore news revision",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor recorded in the revision",
+                        "name": "X-Editor",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Revision restored",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or category of the revision no longer exists",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Revision not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/submit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move news from draft to review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflow"
+                ],
+                "summary": "Submit news for review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status changed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/translations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the translations of news to the configured locales and the locales it is not translated to yet\nTranslations are added and edited with POST /edit/{id}?lang={locale}",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Get news translations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Translations",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.TranslationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/translations/{locale}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete the translation of news to a locale; news is then shown in the fallback locales",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Delete news translation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID news",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale, example: en",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Translation deleted",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID, unknown or default locale",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News or translation not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Full-text search over title and content with Russian and English morphology. Matches in the title rank higher.\nThe query supports web search syntax: \"quoted phrases\", OR and -excluded words. Highlights are HTML-escaped text with matches wrapped in \u003cb\u003e",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "news"
+                ],
+                "summary": "Search news",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query, max 200 characters",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default=10, max=100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default=0",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category IDs, example: 1,2",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "any (default) - news tagged with any of category, all - with all of them",
+                        "name": "category_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category IDs to exclude",
+                        "name": "exclude_category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also match subcategories of category and exclude_category",
+                        "name": "include_descendants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated statuses (draft, review, published, archived), default=published",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after: date (2025-12-20) or RFC 3339 date-time",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before: date (inclusive) or RFC 3339 date-time",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive substring of the title",
+                        "name": "title_contains",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields (id, title, created_at, updated_at, published_at, relevance), prefix with - for descending, default=-relevance",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Add ContentHTML, the content rendered from its ContentFormat as sanitised HTML",
+                        "name": "html",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ranked news",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error validation params",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sitemap-{chunk}.xml": {
+            "get": {
+                "description": "Sitemap file of published news with IDs from (chunk-1)*50000+1 to chunk*50000, lastmod taken from UpdatedAt.\nDoes not require the bearer token",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "sitemaps"
+                ],
+                "summary": "Sitemap file",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of the sitemap file, from 1",
+                        "name": "chunk",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sitemap",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Sitemap not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_sitemap.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_sitemap.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sitemap.xml": {
+            "get": {
+                "description": "Sitemap index listing the sitemap files of published news, each with the latest change of its news as lastmod.\nNews is split into files by ID ranges of 50000. Does not require the bearer token",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "sitemaps"
+                ],
+                "summary": "Sitemap index",
+                "responses": {
+                    "200": {
+                        "description": "Sitemap index",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_sitemap.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/translations/completeness": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "For each configured locale, the number and percent of news matching the filters that are translated to it. The default locale is always complete\nTakes the filters of GET /list; only published news is counted unless status filter is set",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "translations"
+                ],
+                "summary": "Translation completeness",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category IDs, example: 1,2",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "any (default) - news tagged with any of category, all - with all of them",
+                        "name": "category_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category IDs to exclude",
+                        "name": "exclude_category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also match subcategories of category and exclude_category",
+                        "name": "include_descendants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated statuses (draft, review, published, archived), default=published",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after: date (2025-12-20) or RFC 3339 date-time",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before: date (inclusive) or RFC 3339 date-time",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive substring of the title",
+                        "name": "title_contains",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Completeness by locale",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.TranslationCompletenessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error validation params",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Get trashed news",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "default=10, max=100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "default=0",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List trashed news",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.NewsListsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error validation params",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/purge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permanently delete news that has been in the trash longer than the configured retention period",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Purge trash",
+                "responses": {
+                    "200": {
+                        "description": "Number of purged news",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.PurgeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers_news.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "internal_handlers_category.CategoryListResponse": {
+            "type": "object",
+            "properties": {
+                "Categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.Category"
+                    }
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_category.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "Category": {
+                    "$ref": "#/definitions/service_internal_models.Category"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_category.CategoryTreeResponse": {
+            "type": "object",
+            "properties": {
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "Tree": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.CategoryNode"
+                    }
+                }
+            }
+        },
+        "internal_handlers_category.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "Error": {
+                    "type": "string"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "internal_handlers_category.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_category.SuccessResponseCreate": {
+            "type": "object",
+            "properties": {
+                "Id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_feed.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "Error": {
+                    "type": "string"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "internal_handlers_media.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "Error": {
+                    "type": "string"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "internal_handlers_media.MediaResponse": {
+            "type": "object",
+            "properties": {
+                "Media": {
+                    "$ref": "#/definitions/service_internal_models.Media"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_media.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.BulkCreateResponse": {
+            "type": "object",
+            "properties": {
+                "Created": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "Results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.BulkItemResult"
+                    }
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.BulkEditResponse": {
+            "type": "object",
+            "properties": {
+                "Affected": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "Error": {
+                    "type": "string"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "internal_handlers_news.ImportResponse": {
+            "type": "object",
+            "properties": {
+                "Accepted": {
+                    "type": "integer",
+                    "example": 998
+                },
+                "DryRun": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "Error": {
+                    "description": "Error is set when the import stopped after some news had been created.",
+                    "type": "string",
+                    "example": "Internal server error"
+                },
+                "Rejected": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "Rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.ImportRowResult"
+                    }
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "internal_handlers_news.NewsListsResponse": {
+            "type": "object",
+            "properties": {
+                "Cursor": {
+                    "type": "string"
+                },
+                "HasMore": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "Limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "News": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.NewsWithCategories"
+                    }
+                },
+                "NextCursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiLWlkIiwidiI6WyIxMCJdLCJpZCI6MTB9"
+                },
+                "Offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "PrevCursor": {
+                    "type": "string"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "Total": {
+                    "description": "Total is omitted when counting is disabled with count=none.",
+                    "type": "integer",
+                    "example": 470
+                }
+            }
+        },
+        "internal_handlers_news.NewsResponse": {
+            "type": "object",
+            "properties": {
+                "News": {
+                    "$ref": "#/definitions/service_internal_models.NewsWithCategories"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.PurgeResponse": {
+            "type": "object",
+            "properties": {
+                "Purged": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.RelatedResponse": {
+            "type": "object",
+            "properties": {
+                "News": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.RelatedNews"
+                    }
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.RevisionDiffResponse": {
+            "type": "object",
+            "properties": {
+                "Diff": {
+                    "$ref": "#/definitions/service_internal_models.NewsRevisionDiff"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.RevisionListResponse": {
+            "type": "object",
+            "properties": {
+                "Revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.NewsRevision"
+                    }
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.RevisionResponse": {
+            "type": "object",
+            "properties": {
+                "Revision": {
+                    "$ref": "#/definitions/service_internal_models.NewsRevision"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.SearchResponse": {
+            "type": "object",
+            "properties": {
+                "News": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.NewsSearchResult"
+                    }
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.SuccessResponseCreate": {
+            "type": "object",
+            "properties": {
+                "Id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.TranslationCompletenessResponse": {
+            "type": "object",
+            "properties": {
+                "Locales": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.TranslationCompleteness"
+                    }
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers_news.TranslationsResponse": {
+            "type": "object",
+            "properties": {
+                "DefaultLocale": {
+                    "type": "string",
+                    "example": "ru"
+                },
+                "Missing": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "NewsId": {
+                    "type": "integer"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "Translations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.NewsTranslation"
+                    }
+                }
+            }
+        },
+        "internal_handlers_news.VersionConflictResponse": {
+            "type": "object",
+            "properties": {
+                "CurrentVersion": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "Error": {
+                    "type": "string"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "internal_handlers_sitemap.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "Error": {
+                    "type": "string"
+                },
+                "Success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "service_internal_models.BulkItemResult": {
+            "type": "object",
+            "properties": {
+                "Error": {
+                    "type": "string"
+                },
+                "Id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "Index": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "service_internal_models.Category": {
+            "type": "object",
+            "properties": {
+                "Description": {
+                    "type": "string"
+                },
+                "Id": {
+                    "type": "integer"
+                },
+                "Name": {
+                    "type": "string"
+                },
+                "ParentId": {
+                    "type": "integer"
+                },
+                "Slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "service_internal_models.CategoryCreateForm": {
+            "type": "object",
+            "required": [
+                "Name",
+                "Slug"
+            ],
+            "properties": {
+                "Description": {
+                    "type": "string"
+                },
+                "Name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "ParentId": {
+                    "type": "integer"
+                },
+                "Slug": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "service_internal_models.CategoryEditForm": {
+            "type": "object",
+            "properties": {
+                "Description": {
+                    "type": "string"
+                },
+                "Name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "ParentId": {
+                    "description": "ParentId moves the category under another one; 0 moves it to the root.",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "Slug": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "service_internal_models.CategoryNode": {
+            "type": "object",
+            "properties": {
+                "Children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.CategoryNode"
+                    }
+                },
+                "Description": {
+                    "type": "string"
+                },
+                "Id": {
+                    "type": "integer"
+                },
+                "Name": {
+                    "type": "string"
+                },
+                "ParentId": {
+                    "type": "integer"
+                },
+                "Slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "service_internal_models.ImportRowResult": {
+            "type": "object",
+            "properties": {
+                "Error": {
+                    "type": "string"
+                },
+                "Id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "Line": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "service_internal_models.Media": {
+            "type": "object",
+            "properties": {
+                "ContentType": {
+                    "type": "string"
+                },
+                "CreatedAt": {
+                    "type": "string"
+                },
+                "FileName": {
+                    "description": "FileName is the name the file was uploaded with, kept for display only.",
+                    "type": "string"
+                },
+                "Height": {
+                    "type": "integer"
+                },
+                "Id": {
+                    "type": "integer"
+                },
+                "Size": {
+                    "type": "integer"
+                },
+                "Thumbnails": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_internal_models.Thumbnail"
+                    }
+                },
+                "Width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service_internal_models.NewsBulkEditForm": {
+            "type": "object",
+            "properties": {
+                "Categories": {
+                    "description": "Categories are added by add_categories and removed by remove_categories.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "From": {
+                    "description": "From is replaced with To by replace_category.",
+                    "type": "integer"
+                },
+                "Ids": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "Operation": {
+                    "type": "string",
+                    "example": "add_categories"
+                },
+                "Status": {
+                    "description": "Status is set by set_status, only on news allowed to move to it.",
+                    "type": "string",
+                    "example": "published"
+                },
+                "To": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service_internal_models.NewsCategoriesForm": {
+            "type": "object",
+            "required": [
+                "Categories"
+            ],
+            "properties": {
+                "Categories": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "service_internal_models.NewsCreateForm": {
+            "type": "object",
+            "required": [
+                "Content",
+                "Title"
+            ],
+            "properties": {
+                "Categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "Content": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "ContentFormat": {
+                    "description": "ContentFormat defaults to plain; HTML content is sanitised.",
+                    "type": "string",
+                    "enum": [
+                        "plain",
+                        "markdown",
+                        "html"
+                    ]
+                },
+                "ExpiresAt": {
+                    "type": "string"
+                },
+                "Media": {
+                    "description": "Media lists IDs of uploaded media to attach, in the order they are shown.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "PublishAt": {
+                    "description": "PublishAt is when the scheduler publishes the news. News is created as a draft, so it has to be\nsubmitted for review first: drafts are never published by the scheduler.",
+                    "type": "string"
+                },
+                "Title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "service_internal_models.NewsEditForm": {
+            "type": "object",
+            "properties": {
+                "Categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "Content": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "ContentFormat": {
+                    "description": "ContentFormat changes how the content is rendered; HTML content is sanitised.",
+                    "type": "string",
+                    "enum": [
+                        "plain",
+                        "markdown",
+                        "html"
+                    ]
+                },
+                "ExpiresAt": {
+                    "type": "string"
+                },
+                "Media": {
+                    "description": "Media replaces the attached media, in the order they are shown.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "PublishAt": {
+                    "description": "PublishAt and ExpiresAt are left out of the JSON when nil, as null removes them. Like on create,\nPublishAt only publishes news in review.",
+                    "type": "string"
+                },
+                "Title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service_internal_models.NewsRevision": {
+            "type": "object",
+            "properties": {
+                "Categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "Content": {
+                    "type": "string"
+                },
+                "CreatedAt": {
+                    "type": "string"
+                },
+                "Editor": {
+                    "type": "string"
+                },
+                "Id": {
+                    "type": "integer"
+                },
+                "NewsId": {
+                    "type": "integer"
+                },
+                "Revision": {
+                    "type": "integer"
+                },
+                "Title": {
+                    "type": "string"
+                }
+            }
+        },
+        "service_internal_models.NewsRevisionDiff": {
+            "type": "object",
+            "properties": {
+                "From": {
+                    "type": "integer"
+                },
+                "Lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service_pkg_diff.Line"
+                    }
+                },
+                "NewsId": {
+                    "type": "integer"
+                },
+                "To": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service_internal_models.NewsSearchResult": {
+            "type": "object",
+            "properties": {
+                "Categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "Content": {
+                    "type": "string"
+                },
+                "ContentFormat": {
+                    "description": "ContentFormat is one of ContentFormats.",
+                    "type": "string"
+                },
+                "ContentHTML": {
+                    "description": "ContentHTML is the content rendered as sanitised HTML, set only when a client asks for it.",
+                    "type": "string"
+                },
+                "ContentHighlight": {
+                    "type": "string"
+                },
+                "CreatedAt": {
+                    "type": "string"
+                },
+                "DeletedAt": {
+                    "type": "string"
+                },
+                "ExpiresAt": {
+                    "type": "string"
+                },
+                "Id": {
+                    "type": "integer"
+                },
+                "Locale": {
+                    "description": "Locale is the locale of Title and Content, set only when a client asks for a locale.",
+                    "type": "string",
+                    "example": "en"
+                },
+                "Media": {
+                    "description": "Media lists the IDs of attached media in the order they are shown.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "PublishAt": {
+                    "type": "string"
+                },
+                "PublishedAt": {
+                    "type": "string"
+                },
+                "Rank": {
+                    "type": "number",
+                    "example": 0.6
+                },
+                "Status": {
+                    "type": "string"
+                },
+                "Title": {
+                    "type": "string"
+                },
+                "TitleHighlight": {
+                    "type": "string"
+                },
+                "UpdatedAt": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service_internal_models.NewsTranslation": {
+            "type": "object",
+            "properties": {
+                "Content": {
+                    "type": "string"
+                },
+                "Locale": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "NewsId": {
+                    "type": "integer"
+                },
+                "Title": {
+                    "type": "string"
+                },
+                "UpdatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "service_internal_models.NewsWithCategories": {
+            "type": "object",
+            "properties": {
+                "Categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "Content": {
+                    "type": "string"
+                },
+                "ContentFormat": {
+                    "description": "ContentFormat is one of ContentFormats.",
+                    "type": "string"
+                },
+                "ContentHTML": {
+                    "description": "ContentHTML is the content rendered as sanitised HTML, set only when a client asks for it.",
+                    "type": "string"
+                },
+                "CreatedAt": {
+                    "type": "string"
+                },
+                "DeletedAt": {
+                    "type": "string"
+                },
+                "ExpiresAt": {
+                    "type": "string"
+                },
+                "Id": {
+                    "type": "integer"
+                },
+                "Locale": {
+                    "description": "Locale is the locale of Title and Content, set only when a client asks for a locale.",
+                    "type": "string",
+                    "example": "en"
+                },
+                "Media": {
+                    "description": "Media lists the IDs of attached media in the order they are shown.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "PublishAt": {
+                    "type": "string"
+                },
+                "PublishedAt": {
+                    "type": "string"
+                },
+                "Status": {
+                    "type": "string"
+                },
+                "Title": {
+                    "type": "string"
+                },
+                "UpdatedAt": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service_internal_models.RelatedNews": {
+            "type": "object",
+            "properties": {
+                "Categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "Content": {
+                    "type": "string"
+                },
+                "ContentFormat": {
+                    "description": "ContentFormat is one of ContentFormats.",
+                    "type": "string"
+                },
+                "ContentHTML": {
+                    "description": "ContentHTML is the content rendered as sanitised HTML, set only when a client asks for it.",
+                    "type": "string"
+                },
+                "CreatedAt": {
+                    "type": "string"
+                },
+                "DeletedAt": {
+                    "type": "string"
+                },
+                "ExpiresAt": {
+                    "type": "string"
+                },
+                "Id": {
+                    "type": "integer"
+                },
+                "Locale": {
+                    "description": "Locale is the locale of Title and Content, set only when a client asks for a locale.",
+                    "type": "string",
+                    "example": "en"
+                },
+                "Media": {
+                    "description": "Media lists the IDs of attached media in the order they are shown.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "PublishAt": {
+                    "type": "string"
+                },
+                "PublishedAt": {
+                    "type": "string"
+                },
+                "Score": {
+                    "type": "number",
+                    "example": 0.42
+                },
+                "Status": {
+                    "type": "string"
                 },
-                "Content": {
-                    "type": "string"
-                },
-                "Id": {
-                    "type": "integer"
-                },
                 "Title": {
                     "type": "string"
+                },
+                "UpdatedAt": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service_internal_models.Thumbnail": {
+            "type": "object",
+            "properties": {
+                "ContentType": {
+                    "type": "string"
+                },
+                "Height": {
+                    "type": "integer"
+                },
+                "Size": {
+                    "type": "integer"
+                },
+                "Width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service_internal_models.TranslationCompleteness": {
+            "type": "object",
+            "properties": {
+                "Locale": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "Percent": {
+                    "type": "number",
+                    "example": 84
+                },
+                "Total": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "Translated": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "service_pkg_diff.Line": {
+            "type": "object",
+            "properties": {
+                "Op": {
+                    "type": "string",
+                    "example": "insert"
+                },
+                "Text": {
+                    "type": "string"
                 }
             }
         }
@@ -1,17 +1,28 @@
 package apperrors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrNewsNotFound = errors.New("news not found")
-	ErrInvalidBody  = errors.New("invalid request body")
-	ErrValidation   = errors.New("validation failed")
+	ErrNewsNotFound        = errors.New("news not found")
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrRevisionNotFound    = errors.New("revision not found")
+	ErrMediaNotFound       = errors.New("media not found")
+	ErrTranslationNotFound = errors.New("translation not found")
+	ErrInvalidBody         = errors.New("invalid request body")
+	ErrValidation          = errors.New("validation failed")
+	ErrConflict            = errors.New("conflict")
+	ErrVersionConflict     = errors.New("version conflict")
 )
 
 type AppError struct {
 	Err        error
 	Message    string
 	StatusCode int
+	// CurrentVersion is set on version conflicts so the client can refetch and retry.
+	CurrentVersion *int64
 }
 
 func (e *AppError) Error() string {
@@ -38,6 +49,55 @@ func NewNotFound(message string) *AppError {
 	}
 }
 
+func NewCategoryNotFound(message string) *AppError {
+	return &AppError{
+		Err:        ErrCategoryNotFound,
+		Message:    message,
+		StatusCode: 404,
+	}
+}
+
+func NewRevisionNotFound(message string) *AppError {
+	return &AppError{
+		Err:        ErrRevisionNotFound,
+		Message:    message,
+		StatusCode: 404,
+	}
+}
+
+func NewMediaNotFound(message string) *AppError {
+	return &AppError{
+		Err:        ErrMediaNotFound,
+		Message:    message,
+		StatusCode: 404,
+	}
+}
+
+func NewTranslationNotFound(message string) *AppError {
+	return &AppError{
+		Err:        ErrTranslationNotFound,
+		Message:    message,
+		StatusCode: 404,
+	}
+}
+
+func NewConflict(message string) *AppError {
+	return &AppError{
+		Err:        ErrConflict,
+		Message:    message,
+		StatusCode: 409,
+	}
+}
+
+func NewVersionConflict(currentVersion int64) *AppError {
+	return &AppError{
+		Err:            ErrVersionConflict,
+		Message:        fmt.Sprintf("News was modified by another request, current version is %d", currentVersion),
+		StatusCode:     409,
+		CurrentVersion: &currentVersion,
+	}
+}
+
 func NewValidation(message string) *AppError {
 	return &AppError{
 		Err:        ErrValidation,
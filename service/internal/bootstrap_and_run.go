@@ -6,13 +6,21 @@ import (
 	"fmt"
 	"service/internal/configs"
 	"service/internal/handlers"
+	categoryHandlers "service/internal/handlers/category"
 	"service/internal/handlers/errors"
+	feedHandlers "service/internal/handlers/feed"
+	mediaHandlers "service/internal/handlers/media"
 	"service/internal/handlers/middleware"
 	handler "service/internal/handlers/news"
+	sitemapHandlers "service/internal/handlers/sitemap"
 	"service/internal/repository"
+	"service/internal/scheduler"
 	"service/internal/service"
+	"service/internal/sitemap"
+	"service/pkg/clock"
 	"service/pkg/db"
 	"service/pkg/logger"
+	"service/pkg/storage"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -23,10 +31,12 @@ import (
 )
 
 type Server struct {
-	log    *logger.Logger
-	config configs.Config
-	app    *fiber.App
-	db     *sql.DB
+	log       *logger.Logger
+	config    configs.Config
+	app       *fiber.App
+	db        *sql.DB
+	scheduler *scheduler.Scheduler
+	sitemap   *sitemap.Sitemap
 }
 
 func NewServer(ctx context.Context, log *logger.Logger) (*Server, error) {
@@ -40,13 +50,30 @@ func NewServer(ctx context.Context, log *logger.Logger) (*Server, error) {
 		return nil, fmt.Errorf("failed to init reform db: %w", err)
 	}
 
+	mediaStore, err := storage.NewFileStore(cnf.Media.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init media storage: %w", err)
+	}
+
 	repo := repository.NewNewsRepository(reform, log, ctx)
-	newsService := service.NewNewsService(repo, log)
+	categoryRepo := repository.NewCategoryRepository(reform, log, ctx)
+	mediaRepo := repository.NewMediaRepository(reform, log, ctx)
+	newsSitemap := sitemap.NewSitemap(repo, clock.Real{}, cnf.Sitemap, log)
+	newsService := service.NewNewsService(repo, categoryRepo, clock.Real{}, log, cnf.News, newsSitemap)
+	categoryService := service.NewCategoryService(categoryRepo, log)
+	mediaService := service.NewMediaService(mediaRepo, mediaStore, log, cnf.Media)
 	newsHandler := handler.NewNewsHandler(newsService, log)
+	categoryHandler := categoryHandlers.NewCategoryHandler(categoryService, log)
+	mediaHandler := mediaHandlers.NewMediaHandler(mediaService, log, cnf.Media)
+	feedHandler := feedHandlers.NewFeedHandler(newsService, log, cnf.Feed)
+	sitemapHandler := sitemapHandlers.NewSitemapHandler(newsSitemap, log)
+	publicationScheduler := scheduler.NewScheduler(repo, clock.Real{},
+		time.Duration(cnf.Scheduler.Interval)*time.Second, log)
 	app := fiber.New(fiber.Config{
 		ErrorHandler: errors.ErrorHandler(log),
 		ReadTimeout:  time.Duration(cnf.Service.ReadTimeout) * time.Second,
 		WriteTimeout: time.Duration(cnf.Service.WriteTimeout) * time.Second,
+		BodyLimit:    cnf.Service.BodyLimitMB * 1024 * 1024,
 	})
 
 	app.Use(recover.New(recover.Config{
@@ -62,21 +89,39 @@ func NewServer(ctx context.Context, log *logger.Logger) (*Server, error) {
 
 	app.Get("/swagger/*", fiberSwagger.WrapHandler)
 
-	handlers.SetupRoutes(app, newsHandler,
+	feedMiddlewares := []fiber.Handler{middleware.HTTPLogger(log)}
+	if !cnf.Feed.Public {
+		feedMiddlewares = append(feedMiddlewares, middleware.AuthMiddleware(cnf.BearerToken, log))
+	}
+	handlers.SetupFeedRoutes(app, feedHandler, feedMiddlewares...)
+	handlers.SetupSitemapRoutes(app, sitemapHandler, middleware.HTTPLogger(log))
+
+	mediaMiddlewares := []fiber.Handler{middleware.HTTPLogger(log)}
+	if !cnf.Media.Public {
+		mediaMiddlewares = append(mediaMiddlewares, middleware.AuthMiddleware(cnf.BearerToken, log))
+	}
+	handlers.SetupMediaRoutes(app, mediaHandler, mediaMiddlewares...)
+
+	handlers.SetupRoutes(app, newsHandler, categoryHandler, mediaHandler,
 		middleware.HTTPLogger(log),
 		middleware.AuthMiddleware(cnf.BearerToken, log))
 
 	return &Server{
-		config: cnf,
-		app:    app,
-		db:     database,
-		log:    log,
+		config:    cnf,
+		app:       app,
+		db:        database,
+		log:       log,
+		scheduler: publicationScheduler,
+		sitemap:   newsSitemap,
 	}, nil
 }
 
 func (s *Server) Start() error {
 	s.log.Infof("Start server on port %s", s.config.Port)
 
+	s.scheduler.Start()
+	s.sitemap.Start()
+
 	if err := s.app.Listen(":" + s.config.Port); err != nil {
 		return fmt.Errorf("error start server: %w", err)
 	}
@@ -86,6 +131,19 @@ func (s *Server) Start() error {
 
 func (s *Server) Stop(ctx context.Context) error {
 	s.log.Info("Start shutdown service")
+
+	if err := s.scheduler.Stop(ctx); err != nil {
+		s.log.Errorf("Error stop scheduler: %v", err)
+		return fmt.Errorf("error stop scheduler: %w", err)
+	}
+	s.log.Info("Scheduler stopped successfully")
+
+	if err := s.sitemap.Stop(ctx); err != nil {
+		s.log.Errorf("Error stop sitemap: %v", err)
+		return fmt.Errorf("error stop sitemap: %w", err)
+	}
+	s.log.Info("Sitemap stopped successfully")
+
 	g, ctx := errgroup.WithContext(ctx)
 
 	g.Go(func() error {
@@ -1,12 +1,19 @@
 package configs
 
 import (
+	"fmt"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
 type Config struct {
 	Database    Database
 	Service     Service
+	News        News
+	Scheduler   Scheduler
+	Feed        Feed
+	Sitemap     Sitemap
+	Media       Media
 	BearerToken string `envconfig:"BEARER_TOKEN" required:"true"`
 	Port        string `envconfig:"PORT" default:":8080"`
 }
@@ -24,6 +31,64 @@ type Database struct {
 type Service struct {
 	ReadTimeout  int `envconfig:"SERVICE_READ_TIMEOUT" default:"10"`
 	WriteTimeout int `envconfig:"SERVICE_WRITE_TIMEOUT" default:"10"`
+	// BodyLimitMB caps request bodies, including files uploaded to POST /import.
+	BodyLimitMB int `envconfig:"SERVICE_BODY_LIMIT_MB" default:"32"`
+}
+
+type News struct {
+	TrashRetentionDays int `envconfig:"TRASH_RETENTION_DAYS" default:"30"`
+	// DefaultLocale is the locale news title and content are written in; other locales are stored as translations.
+	DefaultLocale string `envconfig:"NEWS_DEFAULT_LOCALE" default:"ru"`
+	// Locales are the locales news may be requested and translated in, including DefaultLocale.
+	Locales []string `envconfig:"NEWS_LOCALES" default:"ru,en"`
+	// FallbackLocales are tried in order when news has no translation to the requested locale, before DefaultLocale.
+	FallbackLocales []string `envconfig:"NEWS_FALLBACK_LOCALES" default:"en"`
+	// RelatedLimit is the number of related news returned unless the request sets limit.
+	RelatedLimit int64 `envconfig:"NEWS_RELATED_LIMIT" default:"5"`
+	// Related weights: how much the shared categories, title similarity and recency count towards the related news score.
+	RelatedCategoryWeight float64 `envconfig:"NEWS_RELATED_CATEGORY_WEIGHT" default:"0.5"`
+	RelatedTitleWeight    float64 `envconfig:"NEWS_RELATED_TITLE_WEIGHT" default:"0.3"`
+	RelatedRecencyWeight  float64 `envconfig:"NEWS_RELATED_RECENCY_WEIGHT" default:"0.2"`
+	// RelatedHalfLifeDays is the age, in days, at which the recency part of the related news score halves.
+	RelatedHalfLifeDays float64 `envconfig:"NEWS_RELATED_HALF_LIFE_DAYS" default:"30"`
+}
+
+type Scheduler struct {
+	// Interval is how often, in seconds, scheduled news is published and expired news archived.
+	Interval int `envconfig:"SCHEDULER_INTERVAL" default:"30"`
+}
+
+type Feed struct {
+	// Public makes the RSS and Atom feeds readable without the bearer token, so feed readers can subscribe.
+	Public bool   `envconfig:"FEED_PUBLIC" default:"false"`
+	Title  string `envconfig:"FEED_TITLE" default:"News"`
+	Size   int64  `envconfig:"FEED_SIZE" default:"20"`
+	// URLTemplate is the public URL of the article in feed links, "{id}" is replaced with the news ID.
+	URLTemplate string `envconfig:"FEED_URL_TEMPLATE" default:"http://localhost:8080/news/{id}"`
+}
+
+type Sitemap struct {
+	// URLTemplate is the public URL of news in sitemaps, "{id}" is replaced with the news ID.
+	URLTemplate string `envconfig:"SITEMAP_URL_TEMPLATE" default:"http://localhost:8080/news/{id}"`
+	// BaseURL is the public URL the sitemap files are served under, for links of the sitemap index.
+	BaseURL         string `envconfig:"SITEMAP_BASE_URL" default:"http://localhost:8080"`
+	PublicationName string `envconfig:"SITEMAP_PUBLICATION_NAME" default:"News"`
+	Language        string `envconfig:"SITEMAP_LANGUAGE" default:"en"`
+	// RefreshInterval is how often, in seconds, sitemaps are rebuilt in full to pick up changes made
+	// outside this replica's service, e.g. by the publication scheduler or by other replicas.
+	RefreshInterval int `envconfig:"SITEMAP_REFRESH_INTERVAL" default:"300"`
+}
+
+type Media struct {
+	// Public makes media files and thumbnails readable without the bearer token, so pages can embed them.
+	Public bool `envconfig:"MEDIA_PUBLIC" default:"false"`
+	// Dir is the directory of the filesystem media storage.
+	Dir       string `envconfig:"MEDIA_DIR" default:"./data/media"`
+	MaxSizeMB int    `envconfig:"MEDIA_MAX_SIZE_MB" default:"10"`
+	// MaxPixels caps width x height of uploaded images, which are decoded in memory; of all frames together for animated GIFs.
+	MaxPixels int `envconfig:"MEDIA_MAX_PIXELS" default:"40000000"`
+	// ThumbnailSizes are the sides of the squares thumbnails are scaled down to fit, comma-separated.
+	ThumbnailSizes []int `envconfig:"MEDIA_THUMBNAIL_SIZES" default:"320,640"`
 }
 
 func NewParsedConfig() (Config, error) {
@@ -33,5 +98,26 @@ func NewParsedConfig() (Config, error) {
 		return config, err
 	}
 
+	if err = config.validate(); err != nil {
+		return config, err
+	}
+
 	return config, nil
 }
+
+// validate rejects values envconfig accepts but the service cannot run with.
+func (c Config) validate() error {
+	if c.Scheduler.Interval <= 0 {
+		return fmt.Errorf("SCHEDULER_INTERVAL must be positive, got %d", c.Scheduler.Interval)
+	}
+
+	if c.Sitemap.RefreshInterval <= 0 {
+		return fmt.Errorf("SITEMAP_REFRESH_INTERVAL must be positive, got %d", c.Sitemap.RefreshInterval)
+	}
+
+	if c.News.RelatedHalfLifeDays <= 0 {
+		return fmt.Errorf("NEWS_RELATED_HALF_LIFE_DAYS must be positive, got %g", c.News.RelatedHalfLifeDays)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package configs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewParsedConfig(t *testing.T) {
+	required := map[string]string{
+		"BEARER_TOKEN": "token",
+		"DB_PORT":      "5432",
+		"DB_USER":      "user",
+		"DB_PASSWORD":  "password",
+		"DB_NAME":      "news",
+	}
+
+	testData := []struct {
+		name     string
+		env      map[string]string
+		errorMsg string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name:     "zero scheduler interval",
+			env:      map[string]string{"SCHEDULER_INTERVAL": "0"},
+			errorMsg: "SCHEDULER_INTERVAL must be positive, got 0",
+		},
+		{
+			name:     "negative scheduler interval",
+			env:      map[string]string{"SCHEDULER_INTERVAL": "-30"},
+			errorMsg: "SCHEDULER_INTERVAL must be positive, got -30",
+		},
+		{
+			name:     "zero sitemap refresh interval",
+			env:      map[string]string{"SITEMAP_REFRESH_INTERVAL": "0"},
+			errorMsg: "SITEMAP_REFRESH_INTERVAL must be positive, got 0",
+		},
+		{
+			name:     "zero related half-life",
+			env:      map[string]string{"NEWS_RELATED_HALF_LIFE_DAYS": "0"},
+			errorMsg: "NEWS_RELATED_HALF_LIFE_DAYS must be positive, got 0",
+		},
+		{
+			name: "fractional related half-life",
+			env:  map[string]string{"NEWS_RELATED_HALF_LIFE_DAYS": "0.5"},
+		},
+	}
+
+	for _, td := range testData {
+		t.Run(td.name, func(t *testing.T) {
+			for key, value := range required {
+				t.Setenv(key, value)
+			}
+			for key, value := range td.env {
+				t.Setenv(key, value)
+			}
+
+			_, err := NewParsedConfig()
+
+			if td.errorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, td.errorMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"service/internal/apperrors"
+	"service/internal/handlers/request"
+	"service/internal/models"
+	"service/internal/service"
+
+	"service/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CategoryHandler struct {
+	service service.ICategoryService
+	log     *logger.Logger
+}
+
+func NewCategoryHandler(service service.ICategoryService, log *logger.Logger) CategoryHandler {
+	return CategoryHandler{
+		service: service,
+		log:     log,
+	}
+}
+
+type ErrorResponse struct {
+	Success bool   `json:"Success" example:"false"`
+	Error   string `json:"Error"`
+}
+
+type SuccessResponse struct {
+	Success bool `json:"Success" example:"true"`
+}
+
+type SuccessResponseCreate struct {
+	Success bool  `json:"Success" example:"true"`
+	Id      int64 `json:"Id" example:"1"`
+}
+
+type CategoryResponse struct {
+	Success  bool            `json:"Success" example:"true"`
+	Category models.Category `json:"Category"`
+}
+
+type CategoryTreeResponse struct {
+	Success bool                  `json:"Success" example:"true"`
+	Tree    []models.CategoryNode `json:"Tree"`
+}
+
+type CategoryListResponse struct {
+	Success    bool              `json:"Success" example:"true"`
+	Categories []models.Category `json:"Categories"`
+}
+
+// CreateCategory godoc
+// @Summary Create category
+// @Description Create category with name, slug and description(optional). Slug must contain only lowercase letters, digits and hyphens
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param request body models.CategoryCreateForm true "Category data"
+// @Success 201 {object} SuccessResponseCreate "Category created successful"
+// @Failure 400 {object} ErrorResponse "Validation error"
+// @Failure 401 {object} ErrorResponse "No authorization"
+// @Failure 409 {object} ErrorResponse "Slug already exists"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /categories/create [post]
+func (h *CategoryHandler) CreateCategory(c *fiber.Ctx) error {
+	var reqForm models.CategoryCreateForm
+	if err := c.BodyParser(&reqForm); err != nil {
+		return apperrors.NewBadRequest("Failed to parse request body")
+	}
+
+	reqForm.Normalize()
+
+	if err := reqForm.Validate(); err != nil {
+		return apperrors.NewValidation(err.Error())
+	}
+
+	id, err := h.service.CreateCategory(reqForm)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(SuccessResponseCreate{
+		Success: true,
+		Id:      id,
+	})
+}
+
+// EditCategory godoc
+// @Summary Edit category
+// @Description Edit category fields (name, slug, description, parent). ParentId moves the category with its subtree, 0 moves it to the root. Moving a category under itself or its descendant is rejected
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "ID category"
+// @Param request body models.CategoryEditForm true "Category updated data"
+// @Success 200 {object} SuccessResponse "Success updated"
+// @Failure 400 {object} ErrorResponse "Error validation"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "Category not found"
+// @Failure 409 {object} ErrorResponse "Slug already exists"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /categories/edit/{id} [post]
+func (h *CategoryHandler) EditCategory(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	var editForm models.CategoryEditForm
+	if err = c.BodyParser(&editForm); err != nil {
+		return apperrors.NewBadRequest("Failed to parse request body")
+	}
+
+	editForm.Normalize()
+	if err = editForm.Validate(); err != nil {
+		return apperrors.NewValidation(err.Error())
+	}
+
+	if err = h.service.EditCategory(id, editForm); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SuccessResponse{
+		Success: true,
+	})
+}
+
+// GetCategory godoc
+// @Summary Get category by ID
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "ID category"
+// @Success 200 {object} CategoryResponse "Category"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "Category not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /categories/{id} [get]
+func (h *CategoryHandler) GetCategory(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	category, err := h.service.GetCategory(id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(CategoryResponse{Success: true, Category: category})
+}
+
+// ListCategories godoc
+// @Summary Get categories
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param limit query int false "default=10, max=100"
+// @Param offset query int false "default=0"
+// @Success 200 {object} CategoryListResponse "List categories"
+// @Failure 400 {object} ErrorResponse "Error validation params"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /categories/list [get]
+func (h *CategoryHandler) ListCategories(c *fiber.Ctx) error {
+	limit, offset, err := request.ParsePagination(c)
+	if err != nil {
+		return err
+	}
+
+	categories, err := h.service.ListCategories(limit, offset)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(CategoryListResponse{Success: true, Categories: categories})
+}
+
+// DeleteCategory godoc
+// @Summary Delete category
+// @Description Delete category. Categories with subcategories or assigned to news, including news in the trash, cannot be deleted
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "ID category"
+// @Success 200 {object} SuccessResponse "Category deleted"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "Category not found"
+// @Failure 409 {object} ErrorResponse "Category has subcategories or is assigned to news"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /categories/{id} [delete]
+func (h *CategoryHandler) DeleteCategory(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	if err = h.service.DeleteCategory(id); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SuccessResponse{
+		Success: true,
+	})
+}
+
+// GetCategoryTree godoc
+// @Summary Get category tree
+// @Description Get all categories as a tree of nested children
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Success 200 {object} CategoryTreeResponse "Category tree"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /categories/tree [get]
+func (h *CategoryHandler) GetCategoryTree(c *fiber.Ctx) error {
+	tree, err := h.service.GetCategoryTree()
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(CategoryTreeResponse{Success: true, Tree: tree})
+}
@@ -0,0 +1,333 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"service/internal/apperrors"
+	"service/internal/handlers/errors"
+	"service/internal/models"
+	"service/internal/service/mocks"
+	customLog "service/pkg/logger"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+var testLogger = func() *customLog.Logger {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	return &customLog.Logger{Logger: log}
+}()
+
+func setupService(t *testing.T) *mocks.ICategoryService {
+	mockService := new(mocks.ICategoryService)
+
+	t.Cleanup(func() {
+		mockService.AssertExpectations(t)
+	})
+
+	return mockService
+}
+
+func TestCreateCategory(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		createForm := models.CategoryCreateForm{
+			Name:        "Sport",
+			Slug:        "sport",
+			Description: "All about sport",
+		}
+
+		mockService := setupService(t)
+		mockService.On("CreateCategory", createForm).Return(int64(1), nil)
+
+		handler := NewCategoryHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Post("/categories/create", handler.CreateCategory)
+
+		req := httptest.NewRequest("POST", "/categories/create",
+			bytes.NewReader([]byte(`{"Name":" Sport ","Slug":"Sport","Description":"All about sport"}`)))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response SuccessResponseCreate
+		json.Unmarshal(body, &response)
+
+		assert.True(t, response.Success)
+		assert.Equal(t, int64(1), response.Id)
+	})
+
+	invalidRequestData := []struct {
+		name     string
+		body     string
+		errorMsg string
+	}{
+		{
+			name:     "Name is empty",
+			body:     `{"Name":"","Slug":"sport"}`,
+			errorMsg: "Name: field is required",
+		},
+		{
+			name:     "Slug is invalid",
+			body:     `{"Name":"Sport","Slug":"sport news!"}`,
+			errorMsg: "Slug: must contain only lowercase letters, digits and hyphens",
+		},
+	}
+
+	for _, rd := range invalidRequestData {
+		t.Run(fmt.Sprintf("FailedInvalidData_%s", rd.name), func(t *testing.T) {
+			mockService := setupService(t)
+			handler := NewCategoryHandler(mockService, testLogger)
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: errors.ErrorHandler(testLogger),
+			})
+			app.Post("/categories/create", handler.CreateCategory)
+
+			req := httptest.NewRequest("POST", "/categories/create", bytes.NewReader([]byte(rd.body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+			body, _ := io.ReadAll(resp.Body)
+			assert.Contains(t, string(body), rd.errorMsg)
+			mockService.AssertNotCalled(t, "CreateCategory")
+		})
+	}
+}
+
+func TestEditCategory(t *testing.T) {
+	name := "Football"
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("EditCategory", int64(2), models.CategoryEditForm{Name: &name}).Return(nil)
+
+		handler := NewCategoryHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Post("/categories/edit/:id", handler.EditCategory)
+
+		req := httptest.NewRequest("POST", "/categories/edit/2", bytes.NewReader([]byte(`{"Name":"Football"}`)))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("FailedNoFieldsToUpdate", func(t *testing.T) {
+		mockService := setupService(t)
+		handler := NewCategoryHandler(mockService, testLogger)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Post("/categories/edit/:id", handler.EditCategory)
+
+		req := httptest.NewRequest("POST", "/categories/edit/2", bytes.NewReader([]byte(`{}`)))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+		mockService.AssertNotCalled(t, "EditCategory")
+	})
+}
+
+func TestGetCategory(t *testing.T) {
+	category := models.Category{ID: 3, Name: "Sport", Slug: "sport"}
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetCategory", int64(3)).Return(category, nil)
+
+		handler := NewCategoryHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/categories/:id", handler.GetCategory)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/categories/3", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response CategoryResponse
+		json.Unmarshal(body, &response)
+
+		assert.Equal(t, category, response.Category)
+	})
+
+	t.Run("FailedNotFound", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetCategory", int64(3)).Return(models.Category{}, apperrors.NewCategoryNotFound("Category not found"))
+
+		handler := NewCategoryHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/categories/:id", handler.GetCategory)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/categories/3", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestListCategories(t *testing.T) {
+	categories := []models.Category{
+		{ID: 1, Name: "Sport", Slug: "sport"},
+		{ID: 2, Name: "Politics", Slug: "politics"},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("ListCategories", int64(10), int64(0)).Return(categories, nil)
+
+		handler := NewCategoryHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/categories/list", handler.ListCategories)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/categories/list", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response CategoryListResponse
+		json.Unmarshal(body, &response)
+
+		assert.Equal(t, categories, response.Categories)
+	})
+}
+
+func TestDeleteCategory(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("DeleteCategory", int64(5)).Return(nil)
+
+		handler := NewCategoryHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Delete("/categories/:id", handler.DeleteCategory)
+
+		resp, err := app.Test(httptest.NewRequest("DELETE", "/categories/5", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("FailedAssignedToNews", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("DeleteCategory", int64(5)).
+			Return(apperrors.NewConflict("Category is assigned to news, including news in the trash, remove it from them first"))
+
+		handler := NewCategoryHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Delete("/categories/:id", handler.DeleteCategory)
+
+		resp, err := app.Test(httptest.NewRequest("DELETE", "/categories/5", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusConflict, resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "Category is assigned to news")
+	})
+}
+
+func TestGetCategoryTree(t *testing.T) {
+	parentID := int64(1)
+	tree := []models.CategoryNode{
+		{
+			Category: models.Category{ID: parentID, Name: "Sport", Slug: "sport"},
+			Children: []models.CategoryNode{
+				{
+					Category: models.Category{ID: 2, Name: "Football", Slug: "football", ParentId: &parentID},
+					Children: []models.CategoryNode{},
+				},
+			},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetCategoryTree").Return(tree, nil)
+
+		handler := NewCategoryHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/categories/tree", handler.GetCategoryTree)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/categories/tree", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response CategoryTreeResponse
+		json.Unmarshal(body, &response)
+
+		assert.Equal(t, tree, response.Tree)
+	})
+
+	t.Run("FailedMoveUnderDescendant", func(t *testing.T) {
+		newParent := int64(2)
+		mockService := setupService(t)
+		mockService.On("EditCategory", int64(1), models.CategoryEditForm{ParentId: &newParent}).
+			Return(apperrors.NewValidation("ParentId: category cannot be moved under itself or its descendant"))
+
+		handler := NewCategoryHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Post("/categories/edit/:id", handler.EditCategory)
+
+		req := httptest.NewRequest("POST", "/categories/edit/1", bytes.NewReader([]byte(`{"ParentId":2}`)))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "cannot be moved under itself")
+	})
+}
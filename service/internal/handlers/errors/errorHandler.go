@@ -3,6 +3,7 @@ package errors
 import (
 	"errors"
 	"service/internal/apperrors"
+	"service/internal/handlers/request"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
@@ -11,19 +12,26 @@ import (
 )
 
 type ErrorResponse struct {
-	Success bool   `json:"Success"`
-	Error   string `json:"Error" validate:"omitempty"`
+	Success        bool   `json:"Success"`
+	Error          string `json:"Error" validate:"omitempty"`
+	CurrentVersion *int64 `json:"CurrentVersion,omitempty"`
 }
 
 func ErrorHandler(log *logger.Logger) fiber.ErrorHandler {
 	return func(c *fiber.Ctx, err error) error {
 		code := fiber.StatusInternalServerError
 		message := "Internal server error"
+		var currentVersion *int64
 
 		var appErr *apperrors.AppError
 		if errors.As(err, &appErr) {
 			code = appErr.StatusCode
 			message = appErr.Message
+			currentVersion = appErr.CurrentVersion
+
+			if currentVersion != nil {
+				c.Set(fiber.HeaderETag, request.FormatETag(*currentVersion))
+			}
 
 			if code >= 500 {
 				log.WithFields(logrus.Fields{
@@ -53,8 +61,9 @@ func ErrorHandler(log *logger.Logger) fiber.ErrorHandler {
 		}
 
 		return c.Status(code).JSON(ErrorResponse{
-			Success: false,
-			Error:   message,
+			Success:        false,
+			Error:          message,
+			CurrentVersion: currentVersion,
 		})
 	}
 }
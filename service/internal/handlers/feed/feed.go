@@ -0,0 +1,309 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"service/internal/configs"
+	"service/internal/handlers/request"
+	"service/internal/models"
+	"service/internal/service"
+	"strconv"
+	"strings"
+	"time"
+
+	"service/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	rssContentType  = "application/rss+xml; charset=utf-8"
+	atomContentType = "application/atom+xml; charset=utf-8"
+	atomNamespace   = "http://www.w3.org/2005/Atom"
+)
+
+type FeedHandler struct {
+	service service.INewsService
+	log     *logger.Logger
+	config  configs.Feed
+}
+
+func NewFeedHandler(service service.INewsService, log *logger.Logger, config configs.Feed) FeedHandler {
+	return FeedHandler{
+		service: service,
+		log:     log,
+		config:  config,
+	}
+}
+
+type ErrorResponse struct {
+	Success bool   `json:"Success" example:"false"`
+	Error   string `json:"Error"`
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate,omitempty"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	XMLNS   string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published,omitempty"`
+	Updated    string         `xml:"updated"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomContent    `xml:"content"`
+}
+
+type atomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// RSS godoc
+// @Summary RSS feed
+// @Description RSS 2.0 feed of the latest published news, of a category and its subcategories when the ID is given.
+// @Description Supports conditional requests with If-None-Match. The bearer token is not required when FEED_PUBLIC is set
+// @Tags feeds
+// @Produce xml
+// @Param id path int false "ID category"
+// @Success 200 {string} string "RSS feed"
+// @Success 304 "Feed not modified"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "Category not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /feed.rss [get]
+// @Router /categories/{id}/feed.rss [get]
+func (h *FeedHandler) RSS(c *fiber.Ctx) error {
+	feed, err := h.feed(c)
+	if err != nil {
+		return err
+	}
+
+	site := c.BaseURL()
+	channel := rssChannel{
+		Title:       h.title(feed),
+		Link:        site,
+		Description: h.description(feed),
+		Items:       make([]rssItem, 0, len(feed.News)),
+	}
+
+	if updated := lastModified(feed); !updated.IsZero() {
+		channel.LastBuildDate = updated.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, news := range feed.News {
+		link := h.newsLink(news.ID)
+		item := rssItem{
+			Title:       news.Title,
+			Link:        link,
+			Description: news.Content,
+			GUID:        rssGUID{IsPermaLink: true, Value: link},
+			Categories:  make([]string, 0, len(news.Categories)),
+		}
+
+		if news.PublishedAt != nil {
+			item.PubDate = news.PublishedAt.UTC().Format(time.RFC1123Z)
+		}
+
+		for _, id := range news.Categories {
+			if category, ok := feed.Categories[id]; ok {
+				item.Categories = append(item.Categories, category.Name)
+			}
+		}
+
+		channel.Items = append(channel.Items, item)
+	}
+
+	return h.send(c, rss{Version: "2.0", Channel: channel}, rssContentType)
+}
+
+// Atom godoc
+// @Summary Atom feed
+// @Description Atom feed of the latest published news, of a category and its subcategories when the ID is given.
+// @Description Supports conditional requests with If-None-Match. The bearer token is not required when FEED_PUBLIC is set
+// @Tags feeds
+// @Produce xml
+// @Param id path int false "ID category"
+// @Success 200 {string} string "Atom feed"
+// @Success 304 "Feed not modified"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "Category not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /feed.atom [get]
+// @Router /categories/{id}/feed.atom [get]
+func (h *FeedHandler) Atom(c *fiber.Ctx) error {
+	feed, err := h.feed(c)
+	if err != nil {
+		return err
+	}
+
+	site := c.BaseURL()
+	self := site + c.Path()
+	updated := lastModified(feed)
+	if updated.IsZero() {
+		updated = time.Unix(0, 0)
+	}
+
+	atom := atomFeed{
+		XMLNS:   atomNamespace,
+		ID:      self,
+		Title:   h.title(feed),
+		Updated: updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "self", Type: "application/atom+xml", Href: self},
+			{Rel: "alternate", Href: site},
+		},
+		Author:  atomAuthor{Name: h.config.Title},
+		Entries: make([]atomEntry, 0, len(feed.News)),
+	}
+
+	for _, news := range feed.News {
+		link := h.newsLink(news.ID)
+		entry := atomEntry{
+			ID:         link,
+			Title:      news.Title,
+			Link:       atomLink{Rel: "alternate", Href: link},
+			Updated:    news.UpdatedAt.UTC().Format(time.RFC3339),
+			Categories: make([]atomCategory, 0, len(news.Categories)),
+			Content:    atomContent{Type: "text", Value: news.Content},
+		}
+
+		if news.PublishedAt != nil {
+			entry.Published = news.PublishedAt.UTC().Format(time.RFC3339)
+		}
+
+		for _, id := range news.Categories {
+			if category, ok := feed.Categories[id]; ok {
+				entry.Categories = append(entry.Categories, atomCategory{Term: category.Slug, Label: category.Name})
+			}
+		}
+
+		atom.Entries = append(atom.Entries, entry)
+	}
+
+	return h.send(c, atom, atomContentType)
+}
+
+func (h *FeedHandler) feed(c *fiber.Ctx) (models.Feed, error) {
+	var categoryId *int64
+	if c.Params("id") != "" {
+		id, err := request.ParseID(c)
+		if err != nil {
+			return models.Feed{}, err
+		}
+		categoryId = &id
+	}
+
+	return h.service.Feed(categoryId, h.config.Size)
+}
+
+// send renders the feed and answers 304 Not Modified when the client already has it. The ETag is a hash
+// of the rendered feed, so it changes with the news, their categories and the feed links alike. No Last-Modified
+// is sent: news leaving the feed, when archived, deleted or pushed out by newer news, does not show in the change
+// times of the news left, so If-Modified-Since could not tell that the feed changed.
+func (h *FeedHandler) send(c *fiber.Ctx, feed any, contentType string) error {
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return err
+	}
+	body = append([]byte(xml.Header), body...)
+
+	sum := sha256.Sum256(body)
+	etag := strconv.Quote(hex.EncodeToString(sum[:16]))
+
+	if request.NotModified(c, etag, time.Time{}) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	return c.Status(fiber.StatusOK).Send(body)
+}
+
+func (h *FeedHandler) title(feed models.Feed) string {
+	if feed.Category != nil {
+		return h.config.Title + ": " + feed.Category.Name
+	}
+	return h.config.Title
+}
+
+func (h *FeedHandler) description(feed models.Feed) string {
+	if feed.Category == nil {
+		return "Latest news"
+	}
+	if feed.Category.Description != "" {
+		return feed.Category.Description
+	}
+	return "Latest news in " + feed.Category.Name
+}
+
+// lastModified is the latest change of the news in the feed, zero for an empty feed. It dates the feed in its body.
+func lastModified(feed models.Feed) time.Time {
+	var updated time.Time
+	for _, news := range feed.News {
+		if news.UpdatedAt.After(updated) {
+			updated = news.UpdatedAt
+		}
+	}
+	return updated
+}
+
+// newsLink is the public URL of the article, which feed readers open and use as its ID.
+func (h *FeedHandler) newsLink(id int64) string {
+	return strings.ReplaceAll(h.config.URLTemplate, "{id}", strconv.FormatInt(id, 10))
+}
@@ -0,0 +1,272 @@
+package handlers
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"service/internal/apperrors"
+	"service/internal/configs"
+	"service/internal/handlers/errors"
+	"service/internal/models"
+	"service/internal/service/mocks"
+	customLog "service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testLogger = func() *customLog.Logger {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	return &customLog.Logger{Logger: log}
+}()
+
+var testConfig = configs.Feed{Title: "News", Size: 20, URLTemplate: "https://news.example.org/articles/{id}"}
+
+func setupService(t *testing.T) *mocks.INewsService {
+	mockService := new(mocks.INewsService)
+
+	t.Cleanup(func() {
+		mockService.AssertExpectations(t)
+	})
+
+	return mockService
+}
+
+func setupApp(mockService *mocks.INewsService) *fiber.App {
+	handler := NewFeedHandler(mockService, testLogger, testConfig)
+	app := fiber.New(fiber.Config{ErrorHandler: errors.ErrorHandler(testLogger)})
+	app.Get("/feed.rss", handler.RSS)
+	app.Get("/feed.atom", handler.Atom)
+	app.Get("/categories/:id/feed.rss", handler.RSS)
+	app.Get("/categories/:id/feed.atom", handler.Atom)
+
+	return app
+}
+
+func testFeed() models.Feed {
+	publishedAt := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+
+	return models.Feed{
+		News: []models.NewsWithCategories{
+			{
+				News: models.News{
+					ID:          2,
+					Title:       "Tom & Jerry <live>",
+					Content:     "Cats & mice",
+					Status:      models.NewsStatusPublished,
+					UpdatedAt:   publishedAt.Add(time.Hour),
+					PublishedAt: &publishedAt,
+				},
+				Categories: []int64{1},
+			},
+			{
+				News: models.News{
+					ID:          1,
+					Title:       "Weather",
+					Content:     "Sunny",
+					Status:      models.NewsStatusPublished,
+					UpdatedAt:   publishedAt,
+					PublishedAt: &publishedAt,
+				},
+			},
+		},
+		Categories: map[int64]models.Category{1: {ID: 1, Name: "Sport", Slug: "sport"}},
+	}
+}
+
+func get(t *testing.T, app *fiber.App, url string, headers map[string]string) (*http.Response, string) {
+	req := httptest.NewRequest("GET", url, nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	return resp, string(body)
+}
+
+func TestRSS(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("Feed", (*int64)(nil), int64(20)).Return(testFeed(), nil)
+
+		resp, body := get(t, setupApp(mockService), "/feed.rss", nil)
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, rssContentType, resp.Header.Get("Content-Type"))
+		assert.NotEmpty(t, resp.Header.Get("ETag"))
+		assert.Empty(t, resp.Header.Get("Last-Modified"))
+		assert.Contains(t, body, "<title>Tom &amp; Jerry &lt;live&gt;</title>")
+
+		var feed rss
+		assert.NoError(t, xml.Unmarshal([]byte(body), &feed))
+		assert.Equal(t, "2.0", feed.Version)
+		assert.Equal(t, "News", feed.Channel.Title)
+		assert.Equal(t, "Sat, 20 Dec 2025 11:00:00 +0000", feed.Channel.LastBuildDate)
+		assert.Len(t, feed.Channel.Items, 2)
+
+		item := feed.Channel.Items[0]
+		assert.Equal(t, "Tom & Jerry <live>", item.Title)
+		assert.Equal(t, "Cats & mice", item.Description)
+		assert.Equal(t, "https://news.example.org/articles/2", item.Link)
+		assert.Equal(t, rssGUID{IsPermaLink: true, Value: "https://news.example.org/articles/2"}, item.GUID)
+		assert.Equal(t, "Sat, 20 Dec 2025 10:00:00 +0000", item.PubDate)
+		assert.Equal(t, []string{"Sport"}, item.Categories)
+		assert.Empty(t, feed.Channel.Items[1].Categories)
+	})
+
+	t.Run("SuccessCategory", func(t *testing.T) {
+		feed := testFeed()
+		feed.Category = &models.Category{ID: 1, Name: "Sport", Slug: "sport", Description: "All about sport"}
+
+		mockService := setupService(t)
+		mockService.On("Feed", mock.MatchedBy(func(id *int64) bool {
+			return id != nil && *id == 1
+		}), int64(20)).Return(feed, nil)
+
+		resp, body := get(t, setupApp(mockService), "/categories/1/feed.rss", nil)
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		var parsed rss
+		assert.NoError(t, xml.Unmarshal([]byte(body), &parsed))
+		assert.Equal(t, "News: Sport", parsed.Channel.Title)
+		assert.Equal(t, "All about sport", parsed.Channel.Description)
+	})
+
+	t.Run("NotModifiedETag", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("Feed", (*int64)(nil), int64(20)).Return(testFeed(), nil).Twice()
+		app := setupApp(mockService)
+
+		resp, _ := get(t, app, "/feed.rss", nil)
+		etag := resp.Header.Get("ETag")
+
+		resp, body := get(t, app, "/feed.rss", map[string]string{"If-None-Match": "W/" + etag})
+
+		assert.Equal(t, fiber.StatusNotModified, resp.StatusCode)
+		assert.Equal(t, etag, resp.Header.Get("ETag"))
+		assert.Empty(t, body)
+	})
+
+	t.Run("ModifiedETag", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("Feed", (*int64)(nil), int64(20)).Return(testFeed(), nil)
+
+		resp, _ := get(t, setupApp(mockService), "/feed.rss", map[string]string{
+			"If-None-Match":     `"stale"`,
+			"If-Modified-Since": "Sat, 20 Dec 2025 12:00:00 GMT",
+		})
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	ifModifiedSince := []struct {
+		name   string
+		since  string
+		status int
+	}{
+		// News that left the feed may have changed after the news left in it, so dates are not trusted.
+		{name: "IgnoredModifiedSince", since: "Sat, 20 Dec 2025 11:00:00 GMT", status: fiber.StatusOK},
+		{name: "ModifiedSince", since: "Sat, 20 Dec 2025 10:59:59 GMT", status: fiber.StatusOK},
+		{name: "InvalidModifiedSince", since: "yesterday", status: fiber.StatusOK},
+	}
+
+	for _, tc := range ifModifiedSince {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := setupService(t)
+			mockService.On("Feed", (*int64)(nil), int64(20)).Return(testFeed(), nil)
+
+			resp, _ := get(t, setupApp(mockService), "/feed.rss", map[string]string{"If-Modified-Since": tc.since})
+
+			assert.Equal(t, tc.status, resp.StatusCode)
+		})
+	}
+
+	t.Run("InvalidID", func(t *testing.T) {
+		resp, body := get(t, setupApp(setupService(t)), "/categories/abc/feed.rss", nil)
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+		var response ErrorResponse
+		json.Unmarshal([]byte(body), &response)
+		assert.Equal(t, "Invalid ID format", response.Error)
+	})
+
+	t.Run("CategoryNotFound", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("Feed", mock.Anything, int64(20)).
+			Return(models.Feed{}, apperrors.NewCategoryNotFound("Category with id=5 not found"))
+
+		resp, _ := get(t, setupApp(mockService), "/categories/5/feed.rss", nil)
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestAtom(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("Feed", (*int64)(nil), int64(20)).Return(testFeed(), nil)
+
+		resp, body := get(t, setupApp(mockService), "/feed.atom", nil)
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, atomContentType, resp.Header.Get("Content-Type"))
+		assert.NotEmpty(t, resp.Header.Get("ETag"))
+		assert.Contains(t, body, `<feed xmlns="http://www.w3.org/2005/Atom">`)
+
+		var feed atomFeed
+		assert.NoError(t, xml.Unmarshal([]byte(body), &feed))
+		assert.Equal(t, "http://example.com/feed.atom", feed.ID)
+		assert.Equal(t, "2025-12-20T11:00:00Z", feed.Updated)
+		assert.Equal(t, "News", feed.Author.Name)
+		assert.Len(t, feed.Entries, 2)
+
+		entry := feed.Entries[0]
+		assert.Equal(t, "https://news.example.org/articles/2", entry.ID)
+		assert.Equal(t, "Tom & Jerry <live>", entry.Title)
+		assert.Equal(t, "https://news.example.org/articles/2", entry.Link.Href)
+		assert.Equal(t, "2025-12-20T10:00:00Z", entry.Published)
+		assert.Equal(t, "2025-12-20T11:00:00Z", entry.Updated)
+		assert.Equal(t, []atomCategory{{Term: "sport", Label: "Sport"}}, entry.Categories)
+		assert.Equal(t, atomContent{Type: "text", Value: "Cats & mice"}, entry.Content)
+	})
+
+	t.Run("SuccessEmpty", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("Feed", (*int64)(nil), int64(20)).Return(models.Feed{}, nil)
+
+		resp, body := get(t, setupApp(mockService), "/feed.atom", nil)
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Empty(t, resp.Header.Get("Last-Modified"))
+
+		var feed atomFeed
+		assert.NoError(t, xml.Unmarshal([]byte(body), &feed))
+		assert.Equal(t, "1970-01-01T00:00:00Z", feed.Updated)
+		assert.Empty(t, feed.Entries)
+	})
+
+	t.Run("CategoryNotFound", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("Feed", mock.Anything, int64(20)).
+			Return(models.Feed{}, apperrors.NewCategoryNotFound("Category with id=5 not found"))
+
+		resp, _ := get(t, setupApp(mockService), "/categories/5/feed.atom", nil)
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	})
+}
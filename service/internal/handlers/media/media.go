@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"service/internal/apperrors"
+	"service/internal/configs"
+	"service/internal/handlers/request"
+	"service/internal/models"
+	"service/internal/service"
+	"strconv"
+
+	"service/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// fileCacheControl lets clients and proxies keep media files for good: files of media never change.
+const fileCacheControl = "public, max-age=31536000, immutable"
+
+type MediaHandler struct {
+	service service.IMediaService
+	log     *logger.Logger
+	config  configs.Media
+}
+
+func NewMediaHandler(service service.IMediaService, log *logger.Logger, config configs.Media) MediaHandler {
+	return MediaHandler{
+		service: service,
+		log:     log,
+		config:  config,
+	}
+}
+
+type ErrorResponse struct {
+	Success bool   `json:"Success" example:"false"`
+	Error   string `json:"Error"`
+}
+
+type SuccessResponse struct {
+	Success bool `json:"Success" example:"true"`
+}
+
+type MediaResponse struct {
+	Success bool         `json:"Success" example:"true"`
+	Media   models.Media `json:"Media"`
+}
+
+// UploadMedia godoc
+// @Summary Upload media
+// @Description Upload a JPEG, PNG or GIF image as the file field of a multipart form. The type is checked by the file content, not its name
+// @Description The image is re-encoded without EXIF and other metadata, JPEG images are rotated as their EXIF orientation says. Thumbnails are generated for every configured size
+// @Description Attach media to news by listing its ID in Media of POST /create or POST /edit/{id}
+// @Tags media
+// @Accept mpfd
+// @Produce json
+// @Param file formData file true "Image"
+// @Success 201 {object} MediaResponse "Media uploaded"
+// @Failure 400 {object} ErrorResponse "Missing, too large or unsupported file"
+// @Failure 401 {object} ErrorResponse "No authorization"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /media [post]
+func (h *MediaHandler) UploadMedia(c *fiber.Ctx) error {
+	header, err := c.FormFile(models.MediaFileField)
+	if err != nil {
+		return apperrors.NewBadRequest("file is required")
+	}
+
+	maxSize := int64(h.config.MaxSizeMB) * 1024 * 1024
+	if header.Size > maxSize {
+		return apperrors.NewBadRequest(fmt.Sprintf("file must be at most %d MB", h.config.MaxSizeMB))
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return apperrors.NewBadRequest("failed to read file")
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxSize))
+	if err != nil {
+		return apperrors.NewBadRequest("failed to read file")
+	}
+
+	media, err := h.service.UploadMedia(c.UserContext(), header.Filename, data)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(MediaResponse{Success: true, Media: media})
+}
+
+// GetMedia godoc
+// @Summary Get media by ID
+// @Description Media with its size, dimensions and thumbnails. The file is served by GET /media/{id}/file and thumbnails by GET /media/{id}/thumbnails/{size}
+// @Tags media
+// @Produce json
+// @Param id path int true "ID media"
+// @Success 200 {object} MediaResponse "Media"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "Media not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /media/{id} [get]
+func (h *MediaHandler) GetMedia(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	media, err := h.service.GetMedia(id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(MediaResponse{Success: true, Media: media})
+}
+
+// DeleteMedia godoc
+// @Summary Delete media
+// @Description Delete media with its files. Media attached to news cannot be deleted
+// @Tags media
+// @Produce json
+// @Param id path int true "ID media"
+// @Success 200 {object} SuccessResponse "Media deleted"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "Media not found"
+// @Failure 409 {object} ErrorResponse "Media is attached to news"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /media/{id} [delete]
+func (h *MediaHandler) DeleteMedia(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	if err = h.service.DeleteMedia(c.UserContext(), id); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SuccessResponse{Success: true})
+}
+
+// File godoc
+// @Summary Media file
+// @Description The uploaded image, re-encoded without metadata. Does not require the bearer token when media is public
+// @Tags media
+// @Produce image/jpeg,image/png,image/gif
+// @Param id path int true "ID media"
+// @Success 200 {file} file "Image"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 404 {object} ErrorResponse "Media not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Router /media/{id}/file [get]
+func (h *MediaHandler) File(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	return h.sendFile(c, id, 0)
+}
+
+// Thumbnail godoc
+// @Summary Media thumbnail
+// @Description Thumbnail that fits a size x size square, one of the Thumbnails sizes of the media. JPEG images have JPEG thumbnails, the rest PNG ones
+// @Description Does not require the bearer token when media is public
+// @Tags media
+// @Produce image/jpeg,image/png
+// @Param id path int true "ID media"
+// @Param size path int true "Thumbnail size"
+// @Success 200 {file} file "Thumbnail"
+// @Failure 400 {object} ErrorResponse "Invalid ID or size"
+// @Failure 404 {object} ErrorResponse "Media or thumbnail not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Router /media/{id}/thumbnails/{size} [get]
+func (h *MediaHandler) Thumbnail(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	size, err := strconv.Atoi(c.Params("size"))
+	if err != nil || size <= 0 {
+		return apperrors.NewBadRequest("Invalid thumbnail size")
+	}
+
+	return h.sendFile(c, id, size)
+}
+
+func (h *MediaHandler) sendFile(c *fiber.Ctx, id int64, size int) error {
+	file, err := h.service.OpenMediaFile(c.UserContext(), id, size)
+	if err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderContentType, file.ContentType)
+	c.Set(fiber.HeaderCacheControl, fileCacheControl)
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+
+	// The body is closed once it has been sent.
+	return c.Status(fiber.StatusOK).SendStream(file.Body)
+}
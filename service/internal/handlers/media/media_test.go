@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"service/internal/apperrors"
+	"service/internal/configs"
+	"service/internal/handlers/errors"
+	"service/internal/models"
+	"service/internal/service/mocks"
+	customLog "service/pkg/logger"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testLogger = func() *customLog.Logger {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	return &customLog.Logger{Logger: log}
+}()
+
+var testConfig = configs.Media{MaxSizeMB: 1}
+
+func setupService(t *testing.T) *mocks.IMediaService {
+	mockService := new(mocks.IMediaService)
+
+	t.Cleanup(func() {
+		mockService.AssertExpectations(t)
+	})
+
+	return mockService
+}
+
+func setupApp(mockService *mocks.IMediaService) *fiber.App {
+	handler := NewMediaHandler(mockService, testLogger, testConfig)
+	app := fiber.New(fiber.Config{ErrorHandler: errors.ErrorHandler(testLogger)})
+	app.Post("/media", handler.UploadMedia)
+	app.Get("/media/:id", handler.GetMedia)
+	app.Delete("/media/:id", handler.DeleteMedia)
+	app.Get("/media/:id/file", handler.File)
+	app.Get("/media/:id/thumbnails/:size", handler.Thumbnail)
+
+	return app
+}
+
+func send(t *testing.T, app *fiber.App, req *http.Request) (*http.Response, string) {
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	return resp, string(body)
+}
+
+func upload(t *testing.T, field, filename string, content []byte) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile(field, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(content)
+	writer.Close()
+
+	req := httptest.NewRequest("POST", "/media", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func testMedia() models.Media {
+	return models.Media{
+		ID:          7,
+		FileName:    "photo.png",
+		ContentType: "image/png",
+		Size:        2048,
+		Width:       800,
+		Height:      600,
+		Thumbnails: models.Thumbnails{
+			{Size: 320, Width: 320, Height: 240, ContentType: "image/png"},
+		},
+	}
+}
+
+func TestUploadMedia(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("UploadMedia", mock.Anything, "photo.png", []byte("image data")).Return(testMedia(), nil)
+
+		resp, body := send(t, setupApp(mockService), upload(t, "file", "photo.png", []byte("image data")))
+
+		assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+		var response MediaResponse
+		assert.NoError(t, json.Unmarshal([]byte(body), &response))
+		assert.True(t, response.Success)
+		assert.Equal(t, testMedia(), response.Media)
+	})
+
+	t.Run("FailedMissingFile", func(t *testing.T) {
+		resp, body := send(t, setupApp(setupService(t)), upload(t, "image", "photo.png", []byte("image data")))
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, body, "file is required")
+	})
+
+	t.Run("FailedTooLarge", func(t *testing.T) {
+		resp, body := send(t, setupApp(setupService(t)), upload(t, "file", "photo.png", bytes.Repeat([]byte{0}, 1024*1024+1)))
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, body, "file must be at most 1 MB")
+	})
+
+	t.Run("FailedUnsupportedType", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("UploadMedia", mock.Anything, "notes.txt", []byte("text")).
+			Return(models.Media{}, apperrors.NewValidation("file: must be a JPEG, PNG or GIF image"))
+
+		resp, body := send(t, setupApp(mockService), upload(t, "file", "notes.txt", []byte("text")))
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, body, "file: must be a JPEG, PNG or GIF image")
+	})
+}
+
+func TestGetMedia(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetMedia", int64(7)).Return(testMedia(), nil)
+
+		resp, body := send(t, setupApp(mockService), httptest.NewRequest("GET", "/media/7", nil))
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		var response MediaResponse
+		assert.NoError(t, json.Unmarshal([]byte(body), &response))
+		assert.Equal(t, testMedia(), response.Media)
+	})
+
+	t.Run("FailedNotFound", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetMedia", int64(9)).Return(models.Media{}, apperrors.NewMediaNotFound("Media not found"))
+
+		resp, body := send(t, setupApp(mockService), httptest.NewRequest("GET", "/media/9", nil))
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+		assert.Contains(t, body, "Media not found")
+	})
+}
+
+func TestDeleteMedia(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("DeleteMedia", mock.Anything, int64(7)).Return(nil)
+
+		resp, _ := send(t, setupApp(mockService), httptest.NewRequest("DELETE", "/media/7", nil))
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("FailedAttached", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("DeleteMedia", mock.Anything, int64(7)).
+			Return(apperrors.NewConflict("Media is attached to news, detach it first"))
+
+		resp, body := send(t, setupApp(mockService), httptest.NewRequest("DELETE", "/media/7", nil))
+
+		assert.Equal(t, fiber.StatusConflict, resp.StatusCode)
+		assert.Contains(t, body, "Media is attached to news, detach it first")
+	})
+}
+
+func TestFile(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("OpenMediaFile", mock.Anything, int64(7), 0).
+			Return(models.MediaFile{ContentType: "image/png", Body: io.NopCloser(strings.NewReader("original"))}, nil)
+
+		resp, body := send(t, setupApp(mockService), httptest.NewRequest("GET", "/media/7/file", nil))
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+		assert.Equal(t, fileCacheControl, resp.Header.Get("Cache-Control"))
+		assert.Equal(t, "nosniff", resp.Header.Get("X-Content-Type-Options"))
+		assert.Equal(t, "original", body)
+	})
+
+	t.Run("FailedInvalidID", func(t *testing.T) {
+		resp, _ := send(t, setupApp(setupService(t)), httptest.NewRequest("GET", "/media/abc/file", nil))
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestThumbnail(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("OpenMediaFile", mock.Anything, int64(7), 320).
+			Return(models.MediaFile{ContentType: "image/jpeg", Body: io.NopCloser(strings.NewReader("thumbnail"))}, nil)
+
+		resp, body := send(t, setupApp(mockService), httptest.NewRequest("GET", "/media/7/thumbnails/320", nil))
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, "image/jpeg", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "thumbnail", body)
+	})
+
+	t.Run("FailedUnknownSize", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("OpenMediaFile", mock.Anything, int64(7), 100).
+			Return(models.MediaFile{}, apperrors.NewMediaNotFound("Thumbnail of size 100 not found"))
+
+		resp, body := send(t, setupApp(mockService), httptest.NewRequest("GET", "/media/7/thumbnails/100", nil))
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+		assert.Contains(t, body, "Thumbnail of size 100 not found")
+	})
+
+	t.Run("FailedInvalidSize", func(t *testing.T) {
+		resp, body := send(t, setupApp(setupService(t)), httptest.NewRequest("GET", "/media/7/thumbnails/-5", nil))
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, body, "Invalid thumbnail size")
+	})
+}
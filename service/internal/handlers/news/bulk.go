@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"encoding/json"
+	"service/internal/apperrors"
+	"service/internal/models"
+	"service/internal/validators"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type BulkCreateResponse struct {
+	Success bool                    `json:"Success" example:"true"`
+	Created int                     `json:"Created" example:"2"`
+	Results []models.BulkItemResult `json:"Results"`
+}
+
+// CreateNewsBulk godoc
+// @Summary Create news in bulk
+// @Description Create up to 500 news in one transaction. Every item is validated like in POST /create and gets a result with its index in the request: the ID of the created news or an error
+// @Description Invalid items are skipped unless atomic=true, which creates nothing when any item is invalid; valid items of such a request get the error "not created: batch rejected (atomic)"
+// @Tags news
+// @Accept json
+// @Produce json
+// @Param request body []models.NewsCreateForm true "News data"
+// @Param atomic query bool false "All or nothing, default=false"
+// @Param X-Editor header string false "Editor recorded in the revisions"
+// @Success 201 {object} BulkCreateResponse "All news created"
+// @Success 207 {object} BulkCreateResponse "Some news created, see Results"
+// @Failure 400 {object} BulkCreateResponse "No news created, see Results"
+// @Failure 401 {object} ErrorResponse "No authorization"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /news/bulk [post]
+func (h *NewsHandler) CreateNewsBulk(c *fiber.Ctx) error {
+	atomic := false
+	if value := c.Query("atomic"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return apperrors.NewBadRequest("atomic must be a boolean")
+		}
+		atomic = parsed
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(c.Body(), &items); err != nil {
+		return apperrors.NewValidation("body: must be a JSON array of news")
+	}
+
+	if err := validators.ValidateBulkSize(len(items)); err != nil {
+		return err
+	}
+
+	results := make([]models.BulkItemResult, len(items))
+	forms := make([]models.NewsCreateForm, 0, len(items))
+	positions := make([]int, 0, len(items))
+	for i, item := range items {
+		results[i].Index = i
+
+		form, err := parseBulkCreateForm(item)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+
+		forms = append(forms, form)
+		positions = append(positions, i)
+	}
+
+	if len(forms) > 0 {
+		created, err := h.service.CreateNewsBulk(forms, len(items)-len(forms), c.Get(editorHeader), atomic)
+		if err != nil {
+			return err
+		}
+
+		for i, result := range created {
+			result.Index = positions[i]
+			results[positions[i]] = result
+		}
+	}
+
+	response := BulkCreateResponse{Success: true, Results: results}
+	for _, result := range results {
+		if result.Id != nil {
+			response.Created++
+		}
+		if result.Error != "" {
+			response.Success = false
+		}
+	}
+
+	status := fiber.StatusCreated
+	if !response.Success {
+		status = fiber.StatusMultiStatus
+		if response.Created == 0 {
+			status = fiber.StatusBadRequest
+		}
+	}
+
+	return c.Status(status).JSON(response)
+}
+
+func parseBulkCreateForm(item json.RawMessage) (models.NewsCreateForm, error) {
+	var form models.NewsCreateForm
+
+	if err := validators.ValidateCreateNewsRequest(item); err != nil {
+		return form, err
+	}
+
+	if err := json.Unmarshal(item, &form); err != nil {
+		return form, err
+	}
+
+	form.Normalize()
+
+	if err := form.Validate(); err != nil {
+		return form, err
+	}
+
+	return form, nil
+}
+
+type BulkEditResponse struct {
+	Success  bool  `json:"Success" example:"true"`
+	Affected int64 `json:"Affected" example:"42"`
+}
+
+// BulkEditNews godoc
+// @Summary Edit news in bulk
+// @Description Apply one operation to many news in one transaction: add_categories, remove_categories (Categories), replace_category (From with To) or set_status (Status)
+// @Description Target news by Ids in the body, or by the filter query parameters of GET /list. set_status skips news that cannot move to Status. Affected counts the news actually changed
+// @Tags news
+// @Accept json
+// @Produce json
+// @Param request body models.NewsBulkEditForm true "Operation and target news"
+// @Param category query string false "Comma-separated category IDs, example: 1,2"
+// @Param category_mode query string false "any (default) - news tagged with any of category, all - with all of them" Enums(any, all)
+// @Param exclude_category query string false "Comma-separated category IDs to exclude"
+// @Param include_descendants query bool false "Also match subcategories of category and exclude_category"
+// @Param status query string false "Comma-separated statuses (draft, review, published, archived)"
+// @Param created_from query string false "Created at or after: date (2025-12-20) or RFC 3339 date-time"
+// @Param created_to query string false "Created at or before: date (inclusive) or RFC 3339 date-time"
+// @Param title_contains query string false "Case-insensitive substring of the title"
+// @Param X-Editor header string false "Editor recorded in the revisions"
+// @Success 200 {object} BulkEditResponse "Operation applied"
+// @Failure 400 {object} ErrorResponse "Validation error"
+// @Failure 401 {object} ErrorResponse "No authorization"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /news/bulk/edit [post]
+func (h *NewsHandler) BulkEditNews(c *fiber.Ctx) error {
+	var reqForm models.NewsBulkEditForm
+	if err := json.Unmarshal(c.Body(), &reqForm); err != nil {
+		return apperrors.NewBadRequest("Failed to parse request body")
+	}
+
+	reqForm.Normalize()
+
+	if err := reqForm.Validate(); err != nil {
+		return apperrors.NewValidation(err.Error())
+	}
+
+	filter, err := parseNewsFilter(c)
+	if err != nil {
+		return err
+	}
+
+	if len(reqForm.Ids) > 0 && !filter.IsEmpty() {
+		return apperrors.NewBadRequest("Ids and filter cannot be used together")
+	}
+	if len(reqForm.Ids) == 0 && filter.IsEmpty() {
+		return apperrors.NewBadRequest("Ids or a filter is required")
+	}
+
+	reqForm.Editor = c.Get(editorHeader)
+
+	affected, err := h.service.BulkEditNews(reqForm, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(BulkEditResponse{
+		Success:  true,
+		Affected: affected,
+	})
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"encoding/json"
+	"service/internal/apperrors"
+	"service/internal/handlers/request"
+	"service/internal/models"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AddCategories godoc
+// @Summary Add categories to news
+// @Description Attach categories to news, keeping the ones it already has. Categories the news already has are skipped, so repeating the request is safe
+// @Tags news
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Param request body models.NewsCategoriesForm true "Categories to add"
+// @Param X-Editor header string false "Editor recorded in the revision"
+// @Success 200 {object} NewsResponse "News with updated categories"
+// @Failure 400 {object} ErrorResponse "Validation error or unknown category"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/categories [post]
+func (h *NewsHandler) AddCategories(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	var reqForm models.NewsCategoriesForm
+	if err = json.Unmarshal(c.Body(), &reqForm); err != nil {
+		return apperrors.NewBadRequest("Failed to parse request body")
+	}
+
+	reqForm.Normalize()
+
+	if err = reqForm.Validate(); err != nil {
+		return apperrors.NewValidation(err.Error())
+	}
+
+	news, err := h.service.AddCategories(id, reqForm.Categories, c.Get(editorHeader))
+	if err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderETag, request.FormatETag(news.Version))
+
+	return c.Status(fiber.StatusOK).JSON(NewsResponse{Success: true, News: news})
+}
+
+// RemoveCategory godoc
+// @Summary Remove category from news
+// @Description Detach a category from news, keeping its other categories. Removing a category the news does not have succeeds without changes
+// @Tags news
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Param categoryId path int true "ID category"
+// @Param X-Editor header string false "Editor recorded in the revision"
+// @Success 200 {object} NewsResponse "News with updated categories"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/categories/{categoryId} [delete]
+func (h *NewsHandler) RemoveCategory(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	categoryId, err := strconv.ParseInt(c.Params("categoryId"), 10, 64)
+	if err != nil || categoryId < 1 {
+		return apperrors.NewBadRequest("Invalid category ID format")
+	}
+
+	news, err := h.service.RemoveCategory(id, categoryId, c.Get(editorHeader))
+	if err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderETag, request.FormatETag(news.Version))
+
+	return c.Status(fiber.StatusOK).JSON(NewsResponse{Success: true, News: news})
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"service/internal/apperrors"
+	"service/internal/models"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// exportWriteTimeout bounds each flush of an export. The server write timeout covers the whole response,
+	// so it is pushed forward on every flush, otherwise long exports would be cut off.
+	exportWriteTimeout = 30 * time.Second
+	// exportFlushRows is the number of rows buffered before they are sent to the client.
+	exportFlushRows = 100
+	// exportFlushInterval is the longest time buffered rows wait to be sent, so slow exports still make progress
+	// and the write deadline is renewed before a row arriving after a stall is written.
+	exportFlushInterval = time.Second
+)
+
+var exportContentTypes = map[string]string{
+	models.ExportNDJSON: "application/x-ndjson",
+	models.ExportCSV:    "text/csv; charset=utf-8",
+}
+
+// ExportNews godoc
+// @Summary Export news
+// @Description Stream all news matching the filters of GET /list in ID order, as NDJSON (one news per line) or CSV with a header row and categories joined with ";" in one column
+// @Description Rows are sent as they are read from the database, 100 rows or one second at a time; the export and its database query stop when the client disconnects
+// @Tags news
+// @Produce application/x-ndjson
+// @Produce text/csv
+// @Param format query string false "ndjson (default) or csv" Enums(ndjson, csv)
+// @Param category query string false "Comma-separated category IDs, example: 1,2"
+// @Param category_mode query string false "any (default) - news tagged with any of category, all - with all of them" Enums(any, all)
+// @Param exclude_category query string false "Comma-separated category IDs to exclude"
+// @Param include_descendants query bool false "Also match subcategories of category and exclude_category"
+// @Param status query string false "Comma-separated statuses (draft, review, published, archived), default=published"
+// @Param created_from query string false "Created at or after: date (2025-12-20) or RFC 3339 date-time"
+// @Param created_to query string false "Created at or before: date (inclusive) or RFC 3339 date-time"
+// @Param title_contains query string false "Case-insensitive substring of the title"
+// @Success 200 {file} file "News export"
+// @Failure 400 {object} ErrorResponse "Error validation params"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Security BearerAuth
+// @Router /export [get]
+func (h *NewsHandler) ExportNews(c *fiber.Ctx) error {
+	format := strings.TrimSpace(c.Query("format", models.ExportNDJSON))
+	if !slices.Contains(models.ExportFormats, format) {
+		return apperrors.NewBadRequest(fmt.Sprintf("format must be one of: %s", strings.Join(models.ExportFormats, ", ")))
+	}
+
+	filter, err := parseNewsFilter(c)
+	if err != nil {
+		return err
+	}
+
+	filename := fmt.Sprintf("news-%s.%s", time.Now().UTC().Format("20060102-150405"), format)
+	c.Set(fiber.HeaderContentType, exportContentTypes[format])
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	// The stream writer runs after the handler returns and c is released, so everything it needs is captured here.
+	ctx, cancel := context.WithCancel(c.UserContext())
+	conn := c.Context().Conn()
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		writer := newExportWriter(format, w)
+		buffered := 0
+		flushedAt := time.Now()
+		flush := func() error {
+			buffered = 0
+			flushedAt = time.Now()
+			if err := conn.SetWriteDeadline(flushedAt.Add(exportWriteTimeout)); err != nil {
+				return err
+			}
+			return writer.Flush()
+		}
+		write := func(news models.NewsWithCategories) error {
+			if time.Since(flushedAt) >= exportFlushInterval {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+			if err := writer.Write(news); err != nil {
+				return err
+			}
+			if buffered++; buffered >= exportFlushRows {
+				return flush()
+			}
+			return nil
+		}
+
+		err := h.service.ExportNews(ctx, filter, func(news models.NewsWithCategories) error {
+			if err := write(news); err != nil {
+				// A failed write means the client went away, cancelling stops the cursor query right away.
+				cancel()
+				return err
+			}
+			return nil
+		})
+		if err == nil {
+			err = flush()
+		}
+
+		if err != nil {
+			h.log.WithError(err).WithField("format", format).Warn("News export interrupted")
+		}
+	})
+
+	return nil
+}
+
+// exportWriter encodes news into an export format on top of the response body.
+type exportWriter interface {
+	Write(news models.NewsWithCategories) error
+	Flush() error
+}
+
+func newExportWriter(format string, w *bufio.Writer) exportWriter {
+	if format == models.ExportCSV {
+		writer := csv.NewWriter(w)
+		_ = writer.Write(models.NewsCSVColumns)
+		return &csvExportWriter{body: w, writer: writer}
+	}
+
+	return &ndjsonExportWriter{body: w, encoder: json.NewEncoder(w)}
+}
+
+type ndjsonExportWriter struct {
+	body    *bufio.Writer
+	encoder *json.Encoder
+}
+
+func (n *ndjsonExportWriter) Write(news models.NewsWithCategories) error {
+	return n.encoder.Encode(news)
+}
+
+func (n *ndjsonExportWriter) Flush() error {
+	return n.body.Flush()
+}
+
+type csvExportWriter struct {
+	body   *bufio.Writer
+	writer *csv.Writer
+}
+
+func (c *csvExportWriter) Write(news models.NewsWithCategories) error {
+	return c.writer.Write(news.CSVRecord())
+}
+
+func (c *csvExportWriter) Flush() error {
+	c.writer.Flush()
+	if err := c.writer.Error(); err != nil {
+		return err
+	}
+	return c.body.Flush()
+}
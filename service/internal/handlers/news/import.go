@@ -0,0 +1,397 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"service/internal/apperrors"
+	"service/internal/models"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// importBatchSize is the number of records created in one transaction.
+	importBatchSize = 500
+	// maxImportLineSize caps one NDJSON line.
+	maxImportLineSize = 1024 * 1024
+)
+
+type ImportResponse struct {
+	Success  bool                     `json:"Success" example:"false"`
+	DryRun   bool                     `json:"DryRun" example:"false"`
+	Accepted int                      `json:"Accepted" example:"998"`
+	Rejected int                      `json:"Rejected" example:"2"`
+	Rows     []models.ImportRowResult `json:"Rows"`
+	// Error is set when the import stopped after some news had been created.
+	Error string `json:"Error,omitempty" example:"Internal server error"`
+}
+
+// ImportNews godoc
+// @Summary Import news
+// @Description Create news from an NDJSON file (one news per line, fields of POST /create) or a CSV file with a header row, Title and Content columns and optional Categories (IDs joined with ";"), PublishAt and ExpiresAt; other columns, like the ones of GET /export, are ignored
+// @Description The file is sent as the file field of a multipart form or as the raw body. Every record is validated like in POST /create and news is created in batches of 500, one transaction per batch. The report lists every record by the line it starts on
+// @Description When the import stops on an error after some batches have been created, the response has the error status and the report of the records read so far, with the error in Error; records that were not created have it in their Error
+// @Tags news
+// @Accept mpfd
+// @Produce json
+// @Param file formData file true "NDJSON or CSV file"
+// @Param format query string false "ndjson or csv, default is taken from the file extension or Content-Type, then ndjson" Enums(ndjson, csv)
+// @Param dry_run query bool false "Only validate, create nothing, default=false"
+// @Param X-Editor header string false "Editor recorded in the revisions"
+// @Success 200 {object} ImportResponse "Import report"
+// @Failure 400 {object} ErrorResponse "Unreadable file"
+// @Failure 401 {object} ErrorResponse "No authorization"
+// @Failure 500 {object} ImportResponse "Internal server error, the report is returned when some news has already been created"
+// @Security BearerAuth
+// @Router /import [post]
+func (h *NewsHandler) ImportNews(c *fiber.Ctx) error {
+	dryRun := false
+	if value := c.Query("dry_run"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return apperrors.NewBadRequest("dry_run must be a boolean")
+		}
+		dryRun = parsed
+	}
+
+	source, filename, err := importSource(c)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	format, err := importFormat(c, filename)
+	if err != nil {
+		return err
+	}
+
+	reader, err := newImportReader(format, source)
+	if err != nil {
+		return err
+	}
+
+	importer := newsImporter{handler: h, editor: c.Get(editorHeader), dryRun: dryRun}
+	if err = importer.run(reader); err != nil {
+		if !importer.created {
+			return err
+		}
+		return h.importStopped(c, &importer, err)
+	}
+
+	return c.JSON(importer.report())
+}
+
+// importStopped reports an import that failed after some batches had been created, so the client knows which records to skip when retrying.
+func (h *NewsHandler) importStopped(c *fiber.Ctx, importer *newsImporter, err error) error {
+	status := fiber.StatusInternalServerError
+	message := "Internal server error"
+
+	var appErr *apperrors.AppError
+	if errors.As(err, &appErr) {
+		status = appErr.StatusCode
+		message = appErr.Message
+	}
+
+	h.log.WithError(err).WithField("rows", len(importer.rows)).Error("News import stopped after creating news")
+
+	importer.reject("not imported: " + message)
+
+	response := importer.report()
+	response.Success = false
+	response.Error = message
+
+	return c.Status(status).JSON(response)
+}
+
+// importSource opens the uploaded file, or the raw body when the request is not a multipart form.
+func importSource(c *fiber.Ctx) (io.ReadCloser, string, error) {
+	if !strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		return io.NopCloser(bytes.NewReader(c.Body())), "", nil
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		return nil, "", apperrors.NewBadRequest("file is required")
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open uploaded file: %w", err)
+	}
+
+	return file, header.Filename, nil
+}
+
+func importFormat(c *fiber.Ctx, filename string) (string, error) {
+	if format := strings.TrimSpace(c.Query("format")); format != "" {
+		if !slices.Contains(models.ExportFormats, format) {
+			return "", apperrors.NewBadRequest(fmt.Sprintf("format must be one of: %s", strings.Join(models.ExportFormats, ", ")))
+		}
+		return format, nil
+	}
+
+	if strings.EqualFold(filepath.Ext(filename), ".csv") || strings.HasPrefix(c.Get(fiber.HeaderContentType), "text/csv") {
+		return models.ExportCSV, nil
+	}
+
+	return models.ExportNDJSON, nil
+}
+
+// newsImporter collects valid records into batches and keeps the report rows in file order.
+type newsImporter struct {
+	handler *NewsHandler
+	editor  string
+	dryRun  bool
+	rows    []models.ImportRowResult
+	forms   []models.NewsCreateForm
+	// positions are the indexes in rows of the records in forms.
+	positions []int
+	// created is set once a batch has created news, so a later error cannot be retried as a whole.
+	created bool
+}
+
+// run reads the whole file and creates its records.
+func (i *newsImporter) run(reader importReader) error {
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = i.add(record); err != nil {
+			return err
+		}
+	}
+
+	return i.flush()
+}
+
+// report counts the accepted and rejected records.
+func (i *newsImporter) report() ImportResponse {
+	response := ImportResponse{Success: true, DryRun: i.dryRun, Rows: i.rows}
+	for _, row := range i.rows {
+		if row.Error != "" {
+			response.Rejected++
+			response.Success = false
+		} else {
+			response.Accepted++
+		}
+	}
+
+	return response
+}
+
+// reject marks the records of the batch that was not created.
+func (i *newsImporter) reject(message string) {
+	for _, position := range i.positions {
+		i.rows[position].Error = message
+	}
+
+	i.forms = i.forms[:0]
+	i.positions = i.positions[:0]
+}
+
+func (i *newsImporter) add(record importRecord) error {
+	row := models.ImportRowResult{Line: record.Line}
+
+	var form models.NewsCreateForm
+	err := record.Err
+	if err == nil {
+		form, err = parseBulkCreateForm(record.Item)
+	}
+	if err != nil {
+		row.Error = err.Error()
+		i.rows = append(i.rows, row)
+		return nil
+	}
+
+	i.rows = append(i.rows, row)
+	i.forms = append(i.forms, form)
+	i.positions = append(i.positions, len(i.rows)-1)
+
+	if len(i.forms) == importBatchSize {
+		return i.flush()
+	}
+
+	return nil
+}
+
+// flush creates the collected batch. A validation error of the whole batch rejects its records, other errors abort the import.
+func (i *newsImporter) flush() error {
+	if len(i.forms) == 0 {
+		return nil
+	}
+
+	results, err := i.handler.service.ImportNews(i.forms, i.editor, i.dryRun)
+	if err != nil {
+		var appErr *apperrors.AppError
+		if !errors.As(err, &appErr) || appErr.StatusCode != fiber.StatusBadRequest {
+			return err
+		}
+
+		results = make([]models.BulkItemResult, len(i.forms))
+		for j := range results {
+			results[j] = models.BulkItemResult{Index: j, Error: appErr.Message}
+		}
+	}
+
+	for j, result := range results {
+		row := &i.rows[i.positions[j]]
+		row.Id = result.Id
+		row.Error = result.Error
+		if result.Id != nil {
+			i.created = true
+		}
+	}
+
+	i.forms = i.forms[:0]
+	i.positions = i.positions[:0]
+
+	return nil
+}
+
+// importRecord is one record of an imported file as a POST /create body. Err is set when the record cannot be read.
+type importRecord struct {
+	Line int
+	Item json.RawMessage
+	Err  error
+}
+
+type importReader interface {
+	// Next returns the next record, io.EOF at the end or an error when the rest of the file cannot be read.
+	Next() (importRecord, error)
+}
+
+func newImportReader(format string, source io.Reader) (importReader, error) {
+	if format == models.ExportCSV {
+		return newCSVImportReader(source)
+	}
+
+	scanner := bufio.NewScanner(source)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+	return &ndjsonImportReader{scanner: scanner}, nil
+}
+
+type ndjsonImportReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (n *ndjsonImportReader) Next() (importRecord, error) {
+	for n.scanner.Scan() {
+		n.line++
+
+		line := bytes.TrimSpace(n.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		// The scanner reuses its buffer, while records are kept until their batch is created.
+		return importRecord{Line: n.line, Item: bytes.Clone(line)}, nil
+	}
+
+	if err := n.scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return importRecord{}, apperrors.NewBadRequest(fmt.Sprintf("line %d: longer than %d bytes", n.line+1, maxImportLineSize))
+		}
+		return importRecord{}, err
+	}
+
+	return importRecord{}, io.EOF
+}
+
+// csvImportColumns are the CSV columns turned into POST /create fields, the rest are ignored.
+var csvImportColumns = []string{"Title", "Content", "ContentFormat", "Categories", "PublishAt", "ExpiresAt"}
+
+type csvImportReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+func newCSVImportReader(source io.Reader) (*csvImportReader, error) {
+	reader := csv.NewReader(source)
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, apperrors.NewBadRequest("file is empty")
+	}
+	if err != nil {
+		return nil, apperrors.NewBadRequest(fmt.Sprintf("invalid CSV header: %v", err))
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		// Spreadsheets often save CSV files with a byte order mark before the first column name.
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if slices.Contains(csvImportColumns, name) {
+			columns[name] = i
+		}
+	}
+
+	for _, required := range []string{"Title", "Content"} {
+		if _, ok := columns[required]; !ok {
+			return nil, apperrors.NewBadRequest(fmt.Sprintf("CSV header must contain a %s column", required))
+		}
+	}
+
+	return &csvImportReader{reader: reader, columns: columns}, nil
+}
+
+func (r *csvImportReader) Next() (importRecord, error) {
+	fields, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return importRecord{}, io.EOF
+	}
+
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+			return importRecord{Line: parseErr.StartLine, Err: errors.New("record: wrong number of fields")}, nil
+		}
+		return importRecord{}, apperrors.NewBadRequest(fmt.Sprintf("invalid CSV: %v", err))
+	}
+
+	line, _ := r.reader.FieldPos(0)
+	item, err := r.createRequest(fields)
+	return importRecord{Line: line, Item: item, Err: err}, nil
+}
+
+// createRequest turns a CSV record into a POST /create body, so it goes through the same validation.
+func (r *csvImportReader) createRequest(fields []string) (json.RawMessage, error) {
+	request := map[string]interface{}{
+		"Title":   fields[r.columns["Title"]],
+		"Content": fields[r.columns["Content"]],
+	}
+
+	if i, ok := r.columns["Categories"]; ok && strings.TrimSpace(fields[i]) != "" {
+		categories := make([]int64, 0)
+		for _, part := range strings.Split(fields[i], models.CSVCategorySeparator) {
+			id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Categories: must be IDs separated by %q", models.CSVCategorySeparator)
+			}
+			categories = append(categories, id)
+		}
+		request["Categories"] = categories
+	}
+
+	for _, name := range []string{"ContentFormat", "PublishAt", "ExpiresAt"} {
+		if i, ok := r.columns[name]; ok && strings.TrimSpace(fields[i]) != "" {
+			request[name] = strings.TrimSpace(fields[i])
+		}
+	}
+
+	return json.Marshal(request)
+}
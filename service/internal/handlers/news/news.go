@@ -1,17 +1,25 @@
 package handlers
 
 import (
+	"fmt"
 	"service/internal/apperrors"
+	"service/internal/handlers/request"
 	"service/internal/models"
 	"service/internal/service"
 	"service/internal/validators"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"service/pkg/logger"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// editorHeader names the user recorded as the editor of the revision created by a request.
+const editorHeader = "X-Editor"
+
 type NewsHandler struct {
 	service service.INewsService
 	log     *logger.Logger
@@ -29,6 +37,12 @@ type ErrorResponse struct {
 	Error   string `json:"Error"`
 }
 
+type VersionConflictResponse struct {
+	Success        bool   `json:"Success" example:"false"`
+	Error          string `json:"Error"`
+	CurrentVersion int64  `json:"CurrentVersion" example:"4"`
+}
+
 type SuccessResponse struct {
 	Success bool `json:"Success" example:"true"`
 }
@@ -38,18 +52,39 @@ type SuccessResponseCreate struct {
 	Id      int64 `json:"Id" example:"1"`
 }
 
+type NewsResponse struct {
+	Success bool                      `json:"Success" example:"true"`
+	News    models.NewsWithCategories `json:"News"`
+}
+
 type NewsListsResponse struct {
 	Success bool                        `json:"Success" example:"true"`
 	News    []models.NewsWithCategories `json:"News"`
+	// Total is omitted when counting is disabled with count=none.
+	Total      *int64 `json:"Total,omitempty" example:"470"`
+	Limit      int64  `json:"Limit" example:"10"`
+	Offset     int64  `json:"Offset" example:"0"`
+	Cursor     string `json:"Cursor,omitempty"`
+	HasMore    bool   `json:"HasMore" example:"true"`
+	NextCursor string `json:"NextCursor,omitempty" example:"eyJzIjoiLWlkIiwidiI6WyIxMCJdLCJpZCI6MTB9"`
+	PrevCursor string `json:"PrevCursor,omitempty"`
+}
+
+type PurgeResponse struct {
+	Success bool  `json:"Success" example:"true"`
+	Purged  int64 `json:"Purged" example:"3"`
 }
 
 // CreateNews godoc
 // @Summary Create news
 // @Description Create news with title, content and categories(optional). Categories must be positive integers, example: [1, 2, 3]
+// @Description ContentFormat is plain (default), markdown (CommonMark) or html; HTML content is sanitised with an allow-list before it is saved
+// @Description News is created as a draft: PublishAt takes effect once it is submitted for review, as the scheduler only publishes news in review
 // @Tags news
 // @Accept json
 // @Produce json
 // @Param request body models.NewsCreateForm true "News data"
+// @Param X-Editor header string false "Editor recorded in the revision"
 // @Success 201 {object} SuccessResponseCreate "News created successful"
 // @Failure 400 {object} ErrorResponse "Validation error"
 // @Failure 401 {object} ErrorResponse "No authorization"
@@ -72,6 +107,8 @@ func (h *NewsHandler) CreateNews(c *fiber.Ctx) error {
 		return apperrors.NewValidation(err.Error())
 	}
 
+	reqForm.Editor = c.Get(editorHeader)
+
 	id, err := h.service.CreateNews(reqForm)
 	if err != nil {
 		return err
@@ -85,24 +122,31 @@ func (h *NewsHandler) CreateNews(c *fiber.Ctx) error {
 
 // EditNews godoc
 // @Summary Edit news
-// @Description Edit news fields (title, content, categories). Categories must be positive integers, example: [1, 2, 3]
+// @Description Edit news fields (title, content, content format, categories). Categories must be positive integers, example: [1, 2, 3]
+// @Description Content of news in the html format is sanitised with an allow-list before it is saved, also when news is switched to html
+// @Description Pass the version from the ETag header of GET /news/{id} in If-Match or Version to reject the edit when news changed since it was read
+// @Description PublishAt or ExpiresAt sent as null removes the scheduled publication or expiry; a field left out is kept. PublishAt of a draft takes effect once it is submitted for review
+// @Description With lang other than the default locale only Title and Content of that translation are edited; a new translation needs both. Translation edits bump the version but record no revision
 // @Tags news
 // @Accept json
 // @Produce json
 // @Param id path int true "ID news"
+// @Param lang query string false "Locale of the translation to edit, default is news itself"
 // @Param request body models.NewsEditForm true "News updated data"
+// @Param X-Editor header string false "Editor recorded in the revision"
+// @Param If-Match header string false "Expected news version, example: \"3\""
 // @Success 200 {object} SuccessResponse "Success updated"
 // @Failure 400 {object} ErrorResponse "Error validation"
 // @Failure 401 {object} ErrorResponse "Not authorized"
 // @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 409 {object} VersionConflictResponse "News was modified since the given version"
 // @Failure 500 {object} ErrorResponse "Internal Server Error"
 // @Security BearerAuth
 // @Router /edit/{id} [post]
 func (h *NewsHandler) EditNews(c *fiber.Ctx) error {
-	idParam := c.Params("id")
-	id, err := strconv.ParseUint(idParam, 10, 64)
+	id, err := request.ParseID(c)
 	if err != nil {
-		return apperrors.NewBadRequest("Invalid ID format")
+		return err
 	}
 
 	if err = validators.ValidateEditNewsRequest(c.Body()); err != nil {
@@ -114,12 +158,29 @@ func (h *NewsHandler) EditNews(c *fiber.Ctx) error {
 		return apperrors.NewBadRequest("Failed to parse request body")
 	}
 
+	nulls := request.NullFields(c.Body())
+	editForm.ClearPublishAt, editForm.ClearExpiresAt = nulls["PublishAt"], nulls["ExpiresAt"]
+
 	editForm.Normalize()
 	if err = editForm.Validate(); err != nil {
 		return apperrors.NewValidation(err.Error())
 	}
 
-	if err = h.service.EditNews(int64(id), editForm); err != nil {
+	ifMatch, err := request.ParseIfMatch(c)
+	if err != nil {
+		return err
+	}
+	if ifMatch != nil {
+		if editForm.Version != nil && *editForm.Version != *ifMatch {
+			return apperrors.NewBadRequest("If-Match and Version must not differ")
+		}
+		editForm.Version = ifMatch
+	}
+
+	editForm.Editor = c.Get(editorHeader)
+	editForm.Locale = normalizeLocale(c.Query("lang"))
+
+	if err = h.service.EditNews(id, editForm); err != nil {
 		return err
 	}
 
@@ -130,11 +191,27 @@ func (h *NewsHandler) EditNews(c *fiber.Ctx) error {
 
 // ListNews godoc
 // @Summary Get news
+// @Description Get news list. Only published news is returned unless status filter is set
 // @Tags news
 // @Accept json
 // @Produce json
+// @Description Pages are selected by offset or, for stable paging, by NextCursor/PrevCursor of a previous response passed in cursor
+// @Description The Link header holds first, prev, next and last (offset paging with count=exact only) page URLs
 // @Param limit query int false "default=10, max=100"
-// @Param offset query int false "default=0"
+// @Param offset query int false "default=0, cannot be combined with cursor"
+// @Param cursor query string false "NextCursor or PrevCursor from a previous response; the sort defaults to the one of the cursor"
+// @Param count query string false "How to count Total: exact (default) - COUNT(*), estimated - query planner estimate, none - skip" Enums(exact, estimated, none)
+// @Param category query string false "Comma-separated category IDs, example: 1,2"
+// @Param category_mode query string false "any (default) - news tagged with any of category, all - with all of them" Enums(any, all)
+// @Param exclude_category query string false "Comma-separated category IDs to exclude"
+// @Param include_descendants query bool false "Also match subcategories of category and exclude_category"
+// @Param status query string false "Comma-separated statuses (draft, review, published, archived), default=published"
+// @Param created_from query string false "Created at or after: date (2025-12-20) or RFC 3339 date-time"
+// @Param created_to query string false "Created at or before: date (inclusive) or RFC 3339 date-time"
+// @Param title_contains query string false "Case-insensitive substring of the title"
+// @Param sort query string false "Comma-separated sort fields (id, title, created_at, updated_at, published_at), prefix with - for descending, example: -published_at,title, default=-id"
+// @Param html query bool false "Add ContentHTML, the content rendered from its ContentFormat as sanitised HTML"
+// @Param lang query string false "Locale of Title and Content; news without a translation falls back to the fallback locales, then the default one. Filters and sort use the default locale"
 // @Success 200 {object} NewsListsResponse "List news"
 // @Failure 400 {object} ErrorResponse "Error validation params"
 // @Failure 401 {object} ErrorResponse "Not authorized"
@@ -142,24 +219,500 @@ func (h *NewsHandler) EditNews(c *fiber.Ctx) error {
 // @Security BearerAuth
 // @Router /list [get]
 func (h *NewsHandler) ListNews(c *fiber.Ctx) error {
-	limit, err := strconv.ParseInt(c.Query("limit", "10"), 10, 64)
+	limit, offset, err := request.ParsePagination(c)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := request.ParseCursor(c)
+	if err != nil {
+		return err
+	}
+	if cursor != nil && c.Query("offset") != "" {
+		return apperrors.NewBadRequest("cursor and offset cannot be used together")
+	}
+
+	count := strings.TrimSpace(c.Query("count", models.CountExact))
+	if err = validators.ValidateCountMode(count); err != nil {
+		return err
+	}
+
+	filter, err := parseNewsFilter(c)
+	if err != nil {
+		return err
+	}
+
+	sortValue := c.Query("sort")
+	if cursor != nil && strings.TrimSpace(sortValue) == "" {
+		sortValue = cursor.Sort
+	}
+
+	sort, err := parseNewsSort(sortValue, models.NewsSortFields, models.DefaultNewsSort)
+	if err != nil {
+		return err
+	}
+
+	if cursor != nil {
+		if err = validators.ValidateNewsCursor(*cursor, sort); err != nil {
+			return err
+		}
+	}
+
+	includeHTML, err := parseIncludeHTML(c)
+	if err != nil {
+		return err
+	}
+
+	page, err := h.service.ListNews(limit, offset, cursor, count, filter, sort)
+	if err != nil {
+		return err
+	}
+
+	if err = h.localize(c, page.News); err != nil {
+		return err
+	}
+
+	if includeHTML {
+		for i := range page.News {
+			if err = page.News[i].RenderHTML(); err != nil {
+				return err
+			}
+		}
+	}
+
+	request.SetLinkHeader(c, newsPageLinks(c, page, limit, offset, cursor, count == models.CountExact))
+
+	return c.Status(fiber.StatusOK).JSON(newsListsResponse(page, limit, offset, c.Query("cursor")))
+}
+
+// GetNews godoc
+// @Summary Get news by ID
+// @Description Get a single news item with its categories. The ETag header holds the news version
+// @Tags news
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Param html query bool false "Add ContentHTML, the content rendered from its ContentFormat as sanitised HTML"
+// @Param lang query string false "Locale of Title and Content; without a translation falls back to the fallback locales, then the default one"
+// @Success 200 {object} NewsResponse "News"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id} [get]
+func (h *NewsHandler) GetNews(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	includeHTML, err := parseIncludeHTML(c)
 	if err != nil {
-		return apperrors.NewBadRequest("limit must be a valid number")
+		return err
 	}
 
-	offset, err := strconv.ParseInt(c.Query("offset", "0"), 10, 64)
+	news, err := h.service.GetNewsByID(id)
 	if err != nil {
-		return apperrors.NewBadRequest("offset must be a valid number")
+		return err
 	}
 
-	if err = validators.ValidatePaginationParams(limit, offset); err != nil {
+	localized := []models.NewsWithCategories{news}
+	if err = h.localize(c, localized); err != nil {
 		return err
 	}
+	news = localized[0]
+
+	if includeHTML {
+		if err = news.RenderHTML(); err != nil {
+			return err
+		}
+	}
+
+	c.Set(fiber.HeaderETag, request.FormatETag(news.Version))
 
-	newsList, err := h.service.ListNews(limit, offset)
+	return c.Status(fiber.StatusOK).JSON(NewsResponse{Success: true, News: news})
+}
+
+// DeleteNews godoc
+// @Summary Delete news
+// @Description Move news to the trash. Trashed news is hidden from the list and can be restored until it is purged
+// @Tags trash
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Success 200 {object} SuccessResponse "News moved to trash"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id} [delete]
+func (h *NewsHandler) DeleteNews(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
 	if err != nil {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(NewsListsResponse{Success: true, News: newsList})
+	if err = h.service.DeleteNews(id); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SuccessResponse{
+		Success: true,
+	})
+}
+
+// ListTrash godoc
+// @Summary Get trashed news
+// @Tags trash
+// @Accept json
+// @Produce json
+// @Param limit query int false "default=10, max=100"
+// @Param offset query int false "default=0"
+// @Success 200 {object} NewsListsResponse "List trashed news"
+// @Failure 400 {object} ErrorResponse "Error validation params"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /trash [get]
+func (h *NewsHandler) ListTrash(c *fiber.Ctx) error {
+	limit, offset, err := request.ParsePagination(c)
+	if err != nil {
+		return err
+	}
+
+	page, err := h.service.ListTrash(limit, offset)
+	if err != nil {
+		return err
+	}
+
+	request.SetLinkHeader(c, newsPageLinks(c, page, limit, offset, nil, false))
+
+	return c.Status(fiber.StatusOK).JSON(newsListsResponse(page, limit, offset, ""))
+}
+
+// RestoreNews godoc
+// @Summary Restore news
+// @Description Restore news from the trash
+// @Tags trash
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Success 200 {object} SuccessResponse "News restored"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found in trash"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/restore [post]
+func (h *NewsHandler) RestoreNews(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	if err = h.service.RestoreNews(id); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SuccessResponse{
+		Success: true,
+	})
+}
+
+// PurgeTrash godoc
+// @Summary Purge trash
+// @Description Permanently delete news that has been in the trash longer than the configured retention period
+// @Tags trash
+// @Accept json
+// @Produce json
+// @Success 200 {object} PurgeResponse "Number of purged news"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /trash/purge [post]
+func (h *NewsHandler) PurgeTrash(c *fiber.Ctx) error {
+	purged, err := h.service.PurgeTrash()
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(PurgeResponse{Success: true, Purged: purged})
+}
+
+// SubmitForReview godoc
+// @Summary Submit news for review
+// @Description Move news from draft to review
+// @Tags workflow
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Success 200 {object} SuccessResponse "Status changed"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 409 {object} ErrorResponse "Transition not allowed"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/submit [post]
+func (h *NewsHandler) SubmitForReview(c *fiber.Ctx) error {
+	return h.changeStatus(c, h.service.SubmitForReview)
+}
+
+// Publish godoc
+// @Summary Publish news
+// @Description Move news from review to published
+// @Tags workflow
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Success 200 {object} SuccessResponse "Status changed"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 409 {object} ErrorResponse "Transition not allowed"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/publish [post]
+func (h *NewsHandler) Publish(c *fiber.Ctx) error {
+	return h.changeStatus(c, h.service.Publish)
+}
+
+// Archive godoc
+// @Summary Archive news
+// @Description Move news from published to archived
+// @Tags workflow
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Success 200 {object} SuccessResponse "Status changed"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 409 {object} ErrorResponse "Transition not allowed"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/archive [post]
+func (h *NewsHandler) Archive(c *fiber.Ctx) error {
+	return h.changeStatus(c, h.service.Archive)
+}
+
+// ReturnToDraft godoc
+// @Summary Return news to draft
+// @Description Move news from review, published or archived back to draft
+// @Tags workflow
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Success 200 {object} SuccessResponse "Status changed"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 409 {object} ErrorResponse "Transition not allowed"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/draft [post]
+func (h *NewsHandler) ReturnToDraft(c *fiber.Ctx) error {
+	return h.changeStatus(c, h.service.ReturnToDraft)
+}
+
+func (h *NewsHandler) changeStatus(c *fiber.Ctx, transition func(newsId int64) error) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	if err = transition(id); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SuccessResponse{
+		Success: true,
+	})
+}
+
+func newsListsResponse(page models.NewsPage, limit, offset int64, cursor string) NewsListsResponse {
+	return NewsListsResponse{
+		Success:    true,
+		News:       page.News,
+		Total:      page.Total,
+		Limit:      limit,
+		Offset:     offset,
+		Cursor:     cursor,
+		HasMore:    page.HasMore,
+		NextCursor: request.EncodeCursor(page.NextCursor),
+		PrevCursor: request.EncodeCursor(page.PrevCursor),
+	}
+}
+
+// newsPageLinks links pages the way the current one was requested: by cursor or by offset.
+// The last page is linked only in offset paging with an exact total.
+func newsPageLinks(c *fiber.Ctx, page models.NewsPage, limit, offset int64, cursor *models.NewsCursor, exactTotal bool) request.PageLinks {
+	if cursor != nil {
+		links := request.PageLinks{
+			First: request.PageURL(c, map[string]string{"cursor": "", "sort": cursor.Sort}),
+		}
+		if page.PrevCursor != nil {
+			links.Prev = request.PageURL(c, map[string]string{"cursor": request.EncodeCursor(page.PrevCursor)})
+		}
+		if page.NextCursor != nil {
+			links.Next = request.PageURL(c, map[string]string{"cursor": request.EncodeCursor(page.NextCursor)})
+		}
+		return links
+	}
+
+	atOffset := func(offset int64) string {
+		value := ""
+		if offset > 0 {
+			value = strconv.FormatInt(offset, 10)
+		}
+		return request.PageURL(c, map[string]string{"offset": value})
+	}
+
+	links := request.PageLinks{First: atOffset(0)}
+	if offset > 0 {
+		links.Prev = atOffset(max(offset-limit, 0))
+	}
+	if page.HasMore {
+		links.Next = atOffset(offset + limit)
+	}
+	if exactTotal && page.Total != nil && *page.Total > 0 {
+		links.Last = atOffset((*page.Total - 1) / limit * limit)
+	}
+
+	return links
+}
+
+// parseIncludeHTML reads the html query parameter, which adds ContentHTML to the news of a response.
+func parseIncludeHTML(c *fiber.Ctx) (bool, error) {
+	value := c.Query("html")
+	if value == "" {
+		return false, nil
+	}
+
+	includeHTML, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, apperrors.NewBadRequest("html must be a boolean")
+	}
+
+	return includeHTML, nil
+}
+
+func parseNewsFilter(c *fiber.Ctx) (models.NewsFilter, error) {
+	var filter models.NewsFilter
+	var err error
+
+	if filter.CategoryIDs, err = parseIDList(c.Query("category"), "category"); err != nil {
+		return filter, err
+	}
+
+	if filter.ExcludeCategoryIDs, err = parseIDList(c.Query("exclude_category"), "exclude_category"); err != nil {
+		return filter, err
+	}
+
+	filter.CategoryMode = strings.TrimSpace(c.Query("category_mode"))
+
+	if includeDescendants := c.Query("include_descendants"); includeDescendants != "" {
+		value, err := strconv.ParseBool(includeDescendants)
+		if err != nil {
+			return filter, apperrors.NewBadRequest("include_descendants must be a boolean")
+		}
+		filter.IncludeDescendants = value
+	}
+
+	if statuses := c.Query("status"); statuses != "" {
+		for _, status := range strings.Split(statuses, ",") {
+			filter.Statuses = append(filter.Statuses, strings.TrimSpace(status))
+		}
+	}
+
+	if filter.CreatedFrom, err = parseFilterTime(c.Query("created_from"), "created_from", false); err != nil {
+		return filter, err
+	}
+
+	if filter.CreatedTo, err = parseFilterTime(c.Query("created_to"), "created_to", true); err != nil {
+		return filter, err
+	}
+
+	filter.TitleContains = strings.TrimSpace(c.Query("title_contains"))
+
+	if err = validators.ValidateNewsFilter(filter); err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+// parseIDList parses a comma-separated list of IDs, dropping duplicates.
+func parseIDList(value, name string) ([]int64, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0)
+	for _, part := range strings.Split(value, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			return nil, apperrors.NewBadRequest(fmt.Sprintf("%s must be a comma-separated list of numbers", name))
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// parseFilterTime accepts RFC 3339 or a date. A date in an upper bound means the end of that day (UTC).
+func parseFilterTime(value, name string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, apperrors.NewBadRequest(fmt.Sprintf("%s must be a date (2025-12-20) or RFC 3339 date-time", name))
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+
+	return &t, nil
+}
+
+// parseNewsSort parses comma-separated sort keys, each prefixed with - for descending order.
+// Only fields are accepted, so the sort can safely be turned into SQL.
+func parseNewsSort(value string, fields []string, defaultSort models.NewsSort) (models.NewsSort, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return defaultSort, nil
+	}
+
+	sort := make(models.NewsSort, 0)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+
+		key := models.SortKey{Field: part}
+		if strings.HasPrefix(part, "-") {
+			key = models.SortKey{Field: strings.TrimPrefix(part, "-"), Desc: true}
+		}
+
+		if !slices.Contains(fields, key.Field) {
+			return nil, apperrors.NewBadRequest(
+				fmt.Sprintf("sort must be one of: %s (prefix with - for descending)", strings.Join(fields, ", ")))
+		}
+
+		if slices.ContainsFunc(sort, func(k models.SortKey) bool { return k.Field == key.Field }) {
+			return nil, apperrors.NewBadRequest(fmt.Sprintf("sort field %s is repeated", key.Field))
+		}
+
+		sort = append(sort, key)
+	}
+
+	return sort, nil
 }
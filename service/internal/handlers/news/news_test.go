@@ -2,22 +2,28 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
+	"net/http"
 	"net/http/httptest"
 	"service/internal/apperrors"
 	"service/internal/handlers/errors"
+	"service/internal/handlers/request"
 	"strings"
 
 	"service/internal/models"
 	"service/internal/service/mocks"
 	customLog "service/pkg/logger"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var testLogger = func() *customLog.Logger {
@@ -62,6 +68,22 @@ func TestCreateNews(t *testing.T) {
 				Categories: &categories,
 			},
 		},
+		{
+			name: "with content format",
+			createForm: models.NewsCreateForm{
+				Title:         Title,
+				Content:       Content,
+				ContentFormat: models.ContentFormatMarkdown,
+			},
+		},
+		{
+			name: "with media",
+			createForm: models.NewsCreateForm{
+				Title:   Title,
+				Content: Content,
+				Media:   &[]int64{3, 1},
+			},
+		},
 	}
 
 	for _, rd := range validRequestData {
@@ -97,6 +119,30 @@ func TestCreateNews(t *testing.T) {
 		})
 	}
 
+	t.Run("SuccessDuplicateCategories", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("CreateNews", models.NewsCreateForm{
+			Title:      Title,
+			Content:    Content,
+			Categories: &[]int64{2, 1},
+		}).Return(createdNewsId, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Post("/create", handler.CreateNews)
+
+		req := httptest.NewRequest("POST", "/create", strings.NewReader(
+			`{"Title": "Amazing news", "Content": "This is really amazing news", "Categories": [2, 1, 2, 2]}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+	})
+
 	t.Run("FailedEmptyBody", func(t *testing.T) {
 		mockService := setupService(t)
 		handler := NewNewsHandler(mockService, testLogger)
@@ -148,6 +194,11 @@ func TestCreateNews(t *testing.T) {
 			body:     `{"Title":"Title","Content":"Content", "Categories": ["1"]}`,
 			errorMsg: "Categories: element at index 0 must be number, got string",
 		},
+		{
+			name:     "Media is not integer array",
+			body:     `{"Title":"Title","Content":"Content", "Media": "photo.jpg"}`,
+			errorMsg: "Media: must be array numbers",
+		},
 		{
 			name:     "Categories array contains non-positive integer",
 			body:     `{"Title":"Title","Content":"Content", "Categories": [-1]}`,
@@ -163,6 +214,26 @@ func TestCreateNews(t *testing.T) {
 			body:     `{"Title":"Title","Content":4}`,
 			errorMsg: "Content: must be string",
 		},
+		{
+			name:     "ContentFormat is unknown",
+			body:     `{"Title":"Title","Content":"Content","ContentFormat":"rst"}`,
+			errorMsg: "ContentFormat: must be one of: plain, markdown, html",
+		},
+		{
+			name:     "ContentFormat is not string",
+			body:     `{"Title":"Title","Content":"Content","ContentFormat":1}`,
+			errorMsg: "ContentFormat: must be string",
+		},
+		{
+			name:     "PublishAt is not a date-time",
+			body:     `{"Title":"Title","Content":"Content","PublishAt":"tomorrow"}`,
+			errorMsg: "PublishAt: must be RFC 3339 date-time",
+		},
+		{
+			name:     "ExpiresAt is before PublishAt",
+			body:     `{"Title":"Title","Content":"Content","PublishAt":"2025-12-21T06:00:00Z","ExpiresAt":"2025-12-20T06:00:00Z"}`,
+			errorMsg: "ExpiresAt: must be after PublishAt",
+		},
 	}
 
 	for _, rd := range invalidRequestData {
@@ -245,7 +316,7 @@ func TestListNews(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockService := setupService(t)
-		mockService.On("ListNews", int64(10), int64(0)).Return(newsList, nil)
+		mockService.On("ListNews", int64(10), int64(0), (*models.NewsCursor)(nil), models.CountExact, models.NewsFilter{}, models.DefaultNewsSort).Return(models.NewsPage{News: newsList}, nil)
 
 		handler := NewNewsHandler(mockService, testLogger)
 		app := fiber.New()
@@ -270,7 +341,7 @@ func TestListNews(t *testing.T) {
 
 	t.Run("SuccessWithoutLimitAndOffset", func(t *testing.T) {
 		mockService := setupService(t)
-		mockService.On("ListNews", int64(10), int64(0)).Return(newsList, nil)
+		mockService.On("ListNews", int64(10), int64(0), (*models.NewsCursor)(nil), models.CountExact, models.NewsFilter{}, models.DefaultNewsSort).Return(models.NewsPage{News: newsList}, nil)
 
 		handler := NewNewsHandler(mockService, testLogger)
 		app := fiber.New()
@@ -293,6 +364,178 @@ func TestListNews(t *testing.T) {
 		assert.Equal(t, newsList, response.News)
 	})
 
+	t.Run("SuccessWithCategoryFilter", func(t *testing.T) {
+		filter := models.NewsFilter{CategoryIDs: []int64{3}, IncludeDescendants: true}
+
+		mockService := setupService(t)
+		mockService.On("ListNews", int64(10), int64(0), (*models.NewsCursor)(nil), models.CountExact, filter, models.DefaultNewsSort).Return(models.NewsPage{News: newsList}, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/list", handler.ListNews)
+
+		req := httptest.NewRequest("GET", "/list?category=3&include_descendants=true", nil)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("SuccessWithRichFilter", func(t *testing.T) {
+		createdFrom := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+		createdTo := time.Date(2025, 12, 31, 23, 59, 59, 999999999, time.UTC)
+		filter := models.NewsFilter{
+			CategoryIDs:        []int64{1, 2},
+			CategoryMode:       models.CategoryModeAll,
+			ExcludeCategoryIDs: []int64{5},
+			CreatedFrom:        &createdFrom,
+			CreatedTo:          &createdTo,
+			TitleContains:      "100%",
+		}
+
+		mockService := setupService(t)
+		mockService.On("ListNews", int64(10), int64(0), (*models.NewsCursor)(nil), models.CountExact, filter, models.DefaultNewsSort).Return(models.NewsPage{News: newsList}, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/list", handler.ListNews)
+
+		req := httptest.NewRequest("GET", "/list?category=1,2,2&category_mode=all&exclude_category=5"+
+			"&created_from=2025-12-01T00:00:00Z&created_to=2025-12-31&title_contains=100%25", nil)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("SuccessWithSort", func(t *testing.T) {
+		sort := models.NewsSort{{Field: models.NewsSortPublishedAt, Desc: true}}
+
+		mockService := setupService(t)
+		mockService.On("ListNews", int64(10), int64(0), (*models.NewsCursor)(nil), models.CountExact, models.NewsFilter{}, sort).Return(models.NewsPage{News: newsList}, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/list", handler.ListNews)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/list?sort=-published_at", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("SuccessWithMultiKeySort", func(t *testing.T) {
+		sort := models.NewsSort{{Field: models.NewsSortPublishedAt, Desc: true}, {Field: models.NewsSortTitle}}
+
+		mockService := setupService(t)
+		mockService.On("ListNews", int64(10), int64(0), (*models.NewsCursor)(nil), models.CountExact, models.NewsFilter{}, sort).Return(models.NewsPage{News: newsList}, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/list", handler.ListNews)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/list?sort=-published_at,%20title", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("SuccessWithCursor", func(t *testing.T) {
+		sort := models.NewsSort{{Field: models.NewsSortPublishedAt, Desc: true}}
+		published := "2025-12-20T10:00:00Z"
+		cursor := &models.NewsCursor{Sort: "-published_at", Values: []*string{&published}, ID: 2}
+		next := models.NewsCursor{Sort: "-published_at", Values: []*string{nil}, ID: 1}
+
+		mockService := setupService(t)
+		mockService.On("ListNews", int64(10), int64(0), cursor, models.CountExact, models.NewsFilter{}, sort).
+			Return(models.NewsPage{News: newsList, NextCursor: &next, PrevCursor: cursor}, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/list", handler.ListNews)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/list?cursor="+request.EncodeCursor(cursor), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response NewsListsResponse
+		json.Unmarshal(body, &response)
+
+		assert.Equal(t, request.EncodeCursor(cursor), response.Cursor)
+		assert.Equal(t, request.EncodeCursor(&next), response.NextCursor)
+		assert.Equal(t, request.EncodeCursor(cursor), response.PrevCursor)
+		assert.Contains(t, resp.Header.Get(fiber.HeaderLink), "cursor="+request.EncodeCursor(&next)+`>; rel="next"`)
+	})
+
+	t.Run("SuccessWithPageMetadata", func(t *testing.T) {
+		total := int64(45)
+
+		mockService := setupService(t)
+		mockService.On("ListNews", int64(10), int64(20), (*models.NewsCursor)(nil), models.CountExact, models.NewsFilter{TitleContains: "go"}, models.DefaultNewsSort).
+			Return(models.NewsPage{News: newsList, HasMore: true, Total: &total}, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/list", handler.ListNews)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "http://example.com/list?offset=20&title_contains=go", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, `<http://example.com/list?title_contains=go>; rel="first", `+
+			`<http://example.com/list?offset=10&title_contains=go>; rel="prev", `+
+			`<http://example.com/list?offset=30&title_contains=go>; rel="next", `+
+			`<http://example.com/list?offset=40&title_contains=go>; rel="last"`, resp.Header.Get(fiber.HeaderLink))
+
+		body, _ := io.ReadAll(resp.Body)
+		var response NewsListsResponse
+		json.Unmarshal(body, &response)
+
+		assert.Equal(t, &total, response.Total)
+		assert.Equal(t, int64(10), response.Limit)
+		assert.Equal(t, int64(20), response.Offset)
+		assert.True(t, response.HasMore)
+	})
+
+	t.Run("SuccessWithoutCount", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("ListNews", int64(10), int64(0), (*models.NewsCursor)(nil), models.CountNone, models.NewsFilter{}, models.DefaultNewsSort).
+			Return(models.NewsPage{News: newsList}, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/list", handler.ListNews)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "http://example.com/list?count=none", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, `<http://example.com/list?count=none>; rel="first"`, resp.Header.Get(fiber.HeaderLink))
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.NotContains(t, string(body), `"Total"`)
+	})
+
+	validCursor := request.EncodeCursor(&models.NewsCursor{Sort: "-id", Values: []*string{ptr("5")}, ID: 5})
+
 	invalidPaginationData := []struct {
 		name     string
 		url      string
@@ -323,6 +566,86 @@ func TestListNews(t *testing.T) {
 			url:      "/list?limit=10&offset=abc",
 			errorMsg: "offset must be a valid number",
 		},
+		{
+			name:     "category is not positive",
+			url:      "/list?category=0",
+			errorMsg: "category must be a positive number",
+		},
+		{
+			name:     "sort field is unknown",
+			url:      "/list?sort=content",
+			errorMsg: "sort must be one of: id, title, created_at, updated_at, published_at",
+		},
+		{
+			name:     "sort by relevance without search",
+			url:      "/list?sort=-relevance",
+			errorMsg: "sort must be one of: id, title, created_at, updated_at, published_at",
+		},
+		{
+			name:     "sort field is injected",
+			url:      "/list?sort=title%3BDROP%20TABLE%20news",
+			errorMsg: "sort must be one of",
+		},
+		{
+			name:     "sort field is repeated",
+			url:      "/list?sort=title,-title",
+			errorMsg: "sort field title is repeated",
+		},
+		{
+			name:     "count is unknown",
+			url:      "/list?count=all",
+			errorMsg: "count must be one of: exact, estimated, none",
+		},
+		{
+			name:     "cursor is not base64",
+			url:      "/list?cursor=***",
+			errorMsg: "cursor is malformed",
+		},
+		{
+			name:     "cursor has wrong values",
+			url:      "/list?cursor=" + request.EncodeCursor(&models.NewsCursor{Sort: "-id", Values: []*string{ptr("x")}, ID: 5}),
+			errorMsg: "cursor is malformed",
+		},
+		{
+			name:     "cursor and offset",
+			url:      "/list?offset=10&cursor=" + validCursor,
+			errorMsg: "cursor and offset cannot be used together",
+		},
+		{
+			name:     "cursor for another sort",
+			url:      "/list?sort=created_at&cursor=" + validCursor,
+			errorMsg: "cursor was issued for a different sort order",
+		},
+		{
+			name:     "status is unknown",
+			url:      "/list?status=draft,deleted",
+			errorMsg: "status must be one of: draft, review, published, archived",
+		},
+		{
+			name:     "category_mode is unknown",
+			url:      "/list?category=1,2&category_mode=some",
+			errorMsg: "category_mode must be one of: any, all",
+		},
+		{
+			name:     "category is both included and excluded",
+			url:      "/list?category=1,2&exclude_category=2",
+			errorMsg: "category 2 cannot be both included and excluded",
+		},
+		{
+			name:     "created_from is not a date",
+			url:      "/list?created_from=yesterday",
+			errorMsg: "created_from must be a date (2025-12-20) or RFC 3339 date-time",
+		},
+		{
+			name:     "created range is reversed",
+			url:      "/list?created_from=2025-12-20&created_to=2025-12-10",
+			errorMsg: "created_from must not be after created_to",
+		},
+		{
+			name:     "include_descendants is not a boolean",
+			url:      "/list?category=1&include_descendants=maybe",
+			errorMsg: "include_descendants must be a boolean",
+		},
 	}
 
 	for _, rd := range invalidPaginationData {
@@ -484,9 +807,48 @@ func TestEditNews(t *testing.T) {
 		})
 	}
 
+	t.Run("SuccessClearSchedule", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("EditNews", newsId, models.NewsEditForm{ClearPublishAt: true}).Return(nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Post("/edit/:id", handler.EditNews)
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("/edit/%d", newsId), strings.NewReader(`{"PublishAt": null}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("SuccessKeepScheduleLeftOut", func(t *testing.T) {
+		title := "Title"
+		expiresAt := time.Date(2025, 12, 21, 6, 0, 0, 0, time.UTC)
+		mockService := setupService(t)
+		mockService.On("EditNews", newsId, models.NewsEditForm{Title: &title, ExpiresAt: &expiresAt}).Return(nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Post("/edit/:id", handler.EditNews)
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("/edit/%d", newsId), strings.NewReader(`{"Title":"Title","ExpiresAt":"2025-12-21T06:00:00Z"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
 	t.Run("FailedNoFieldsToUpdate", func(t *testing.T) {
-		editForm := models.NewsEditForm{}
-		requestBody, _ := json.Marshal(editForm)
+		requestBody := []byte(`{"Title":null,"Content":null,"Categories":null}`)
 
 		mockService := setupService(t)
 		handler := NewNewsHandler(mockService, testLogger)
@@ -506,7 +868,7 @@ func TestEditNews(t *testing.T) {
 
 		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
 		body, _ := io.ReadAll(resp.Body)
-		assert.Contains(t, string(body), "body must contain at least one field to update (Title, Content, or Categories)")
+		assert.Contains(t, string(body), "body must contain at least one field to update (Title, Content, ContentFormat, Categories, Media, PublishAt, or ExpiresAt)")
 		mockService.AssertNotCalled(t, "EditNews")
 	})
 
@@ -659,3 +1021,1578 @@ func TestEditNews(t *testing.T) {
 	}
 
 }
+
+func TestGetNews(t *testing.T) {
+	var newsId int64 = 7
+	news := models.NewsWithCategories{
+		News: models.News{
+			ID:      newsId,
+			Title:   "News 7",
+			Content: "Content 7",
+			Version: 3,
+		},
+		Categories: []int64{1, 3},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetNewsByID", newsId).Return(news, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/news/:id", handler.GetNews)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/news/%d", newsId), nil)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response NewsResponse
+		json.Unmarshal(body, &response)
+
+		assert.True(t, response.Success)
+		assert.Equal(t, news, response.News)
+		assert.Equal(t, `"3"`, resp.Header.Get("ETag"))
+	})
+
+	t.Run("SuccessHTML", func(t *testing.T) {
+		markdown := models.NewsWithCategories{
+			News: models.News{
+				ID:            newsId,
+				Title:         "News 7",
+				Content:       "**Content** <script>alert(1)</script>",
+				ContentFormat: models.ContentFormatMarkdown,
+			},
+		}
+
+		mockService := setupService(t)
+		mockService.On("GetNewsByID", newsId).Return(markdown, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/news/:id", handler.GetNews)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/news/%d?html=true", newsId), nil)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response NewsResponse
+		json.Unmarshal(body, &response)
+
+		assert.Equal(t, markdown.Content, response.News.Content)
+		if assert.NotNil(t, response.News.ContentHTML) {
+			assert.Equal(t, "<p><strong>Content</strong> </p>\n", *response.News.ContentHTML)
+		}
+	})
+
+	t.Run("FailedInvalidHTML", func(t *testing.T) {
+		mockService := setupService(t)
+		handler := NewNewsHandler(mockService, testLogger)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/news/:id", handler.GetNews)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/news/%d?html=yes", newsId), nil)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "html must be a boolean")
+	})
+
+	t.Run("FailedNewsNotFound", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetNewsByID", newsId).Return(models.NewsWithCategories{}, apperrors.NewNotFound("News not found"))
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/news/:id", handler.GetNews)
+
+		req := httptest.NewRequest("GET", fmt.Sprintf("/news/%d", newsId), nil)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "News not found")
+	})
+
+	t.Run("FailedInvalidID", func(t *testing.T) {
+		mockService := setupService(t)
+		handler := NewNewsHandler(mockService, testLogger)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/news/:id", handler.GetNews)
+
+		req := httptest.NewRequest("GET", "/news/abc", nil)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "Invalid ID format")
+		mockService.AssertNotCalled(t, "GetNewsByID")
+	})
+}
+
+func TestDeleteNews(t *testing.T) {
+	var newsId int64 = 4
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("DeleteNews", newsId).Return(nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Delete("/news/:id", handler.DeleteNews)
+
+		req := httptest.NewRequest("DELETE", fmt.Sprintf("/news/%d", newsId), nil)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("FailedNewsNotFound", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("DeleteNews", newsId).Return(apperrors.NewNotFound("News not found"))
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Delete("/news/:id", handler.DeleteNews)
+
+		req := httptest.NewRequest("DELETE", fmt.Sprintf("/news/%d", newsId), nil)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestTrash(t *testing.T) {
+	var newsId int64 = 4
+	deletedAt := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	trash := []models.NewsWithCategories{
+		{
+			News: models.News{
+				ID:        newsId,
+				Title:     "Deleted",
+				Content:   "Deleted content",
+				DeletedAt: &deletedAt,
+			},
+			Categories: []int64{},
+		},
+	}
+
+	t.Run("ListSuccess", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("ListTrash", int64(10), int64(0)).Return(models.NewsPage{News: trash}, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/trash", handler.ListTrash)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/trash", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response NewsListsResponse
+		json.Unmarshal(body, &response)
+
+		assert.True(t, response.Success)
+		assert.Equal(t, trash, response.News)
+	})
+
+	t.Run("RestoreSuccess", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("RestoreNews", newsId).Return(nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Post("/news/:id/restore", handler.RestoreNews)
+
+		resp, err := app.Test(httptest.NewRequest("POST", fmt.Sprintf("/news/%d/restore", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("PurgeSuccess", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("PurgeTrash").Return(int64(5), nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Post("/trash/purge", handler.PurgeTrash)
+
+		resp, err := app.Test(httptest.NewRequest("POST", "/trash/purge", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response PurgeResponse
+		json.Unmarshal(body, &response)
+
+		assert.True(t, response.Success)
+		assert.Equal(t, int64(5), response.Purged)
+	})
+}
+
+func TestStatusTransitions(t *testing.T) {
+	var newsId int64 = 6
+
+	routes := []struct {
+		method string
+		path   string
+		route  func(h *NewsHandler) fiber.Handler
+	}{
+		{method: "SubmitForReview", path: "submit", route: func(h *NewsHandler) fiber.Handler { return h.SubmitForReview }},
+		{method: "Publish", path: "publish", route: func(h *NewsHandler) fiber.Handler { return h.Publish }},
+		{method: "Archive", path: "archive", route: func(h *NewsHandler) fiber.Handler { return h.Archive }},
+		{method: "ReturnToDraft", path: "draft", route: func(h *NewsHandler) fiber.Handler { return h.ReturnToDraft }},
+	}
+
+	for _, rt := range routes {
+		t.Run(fmt.Sprintf("Success_%s", rt.method), func(t *testing.T) {
+			mockService := setupService(t)
+			mockService.On(rt.method, newsId).Return(nil)
+
+			handler := NewNewsHandler(mockService, testLogger)
+			app := fiber.New()
+			app.Post("/news/:id/"+rt.path, rt.route(&handler))
+
+			resp, err := app.Test(httptest.NewRequest("POST", fmt.Sprintf("/news/%d/%s", newsId, rt.path), nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		})
+	}
+
+	t.Run("FailedNotAllowed", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("Publish", newsId).Return(apperrors.NewConflict("Cannot change status from draft to published"))
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Post("/news/:id/publish", handler.Publish)
+
+		resp, err := app.Test(httptest.NewRequest("POST", fmt.Sprintf("/news/%d/publish", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusConflict, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "Cannot change status from draft to published")
+	})
+}
+
+func TestRevisions(t *testing.T) {
+	var newsId int64 = 7
+
+	t.Run("Success_Diff", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("DiffRevisions", newsId, int64(1), int64(3)).Return(models.NewsRevisionDiff{
+			NewsId: newsId,
+			From:   1,
+			To:     3,
+		}, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/news/:id/revisions/diff", handler.DiffRevisions)
+
+		resp, err := app.Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d/revisions/diff?from=1&to=3", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Success_RestoreWithEditor", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("RestoreRevision", newsId, int64(2), "alice").Return(nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Post("/news/:id/revisions/:rev/restore", handler.RestoreRevision)
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("/news/%d/revisions/2/restore", newsId), nil)
+		req.Header.Set("X-Editor", "alice")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("FailedDiffMissingParams", func(t *testing.T) {
+		mockService := setupService(t)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/news/:id/revisions/diff", handler.DiffRevisions)
+
+		resp, err := app.Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d/revisions/diff?from=1", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+		mockService.AssertNotCalled(t, "DiffRevisions")
+	})
+
+	t.Run("FailedRevisionNotFound", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetRevision", newsId, int64(9)).Return(models.NewsRevision{}, apperrors.NewRevisionNotFound("Revision not found"))
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/news/:id/revisions/:rev", handler.GetRevision)
+
+		resp, err := app.Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d/revisions/9", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestEditNewsVersion(t *testing.T) {
+	var newsId int64 = 10
+	newTitle := "Updated Title"
+	var version int64 = 3
+
+	t.Run("Success_IfMatch", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("EditNews", newsId, models.NewsEditForm{Title: &newTitle, Version: &version}).Return(nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Post("/edit/:id", handler.EditNews)
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("/edit/%d", newsId), strings.NewReader(`{"Title": "Updated Title"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"3"`)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("FailedStaleVersion", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("EditNews", newsId, models.NewsEditForm{Title: &newTitle, Version: &version}).
+			Return(apperrors.NewVersionConflict(5))
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Post("/edit/:id", handler.EditNews)
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("/edit/%d", newsId), strings.NewReader(`{"Title": "Updated Title", "Version": 3}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusConflict, resp.StatusCode)
+		assert.Equal(t, `"5"`, resp.Header.Get("ETag"))
+
+		body, _ := io.ReadAll(resp.Body)
+		var response VersionConflictResponse
+		json.Unmarshal(body, &response)
+
+		assert.False(t, response.Success)
+		assert.Equal(t, int64(5), response.CurrentVersion)
+	})
+
+	t.Run("FailedIfMatchDiffersFromVersion", func(t *testing.T) {
+		mockService := setupService(t)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Post("/edit/:id", handler.EditNews)
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("/edit/%d", newsId), strings.NewReader(`{"Title": "Updated Title", "Version": 3}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"4"`)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+		mockService.AssertNotCalled(t, "EditNews")
+	})
+}
+
+func TestSearchNews(t *testing.T) {
+	var categoryID int64 = 2
+	results := []models.NewsSearchResult{
+		{
+			NewsWithCategories: models.NewsWithCategories{
+				News:       models.News{ID: 1, Title: "Новости спорта"},
+				Categories: []int64{2},
+			},
+			Rank:           0.6,
+			TitleHighlight: "Новости <b>спорта</b>",
+		},
+	}
+
+	t.Run("Success_WithCategory", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("SearchNews", "спорт футбол", int64(5), int64(10), models.NewsFilter{CategoryIDs: []int64{categoryID}}, models.DefaultSearchSort).
+			Return(results, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/search", handler.SearchNews)
+
+		req := httptest.NewRequest("GET", "/search?q=%20%D1%81%D0%BF%D0%BE%D1%80%D1%82%20%D1%84%D1%83%D1%82%D0%B1%D0%BE%D0%BB&limit=5&offset=10&category=2", nil)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response SearchResponse
+		json.Unmarshal(body, &response)
+
+		assert.True(t, response.Success)
+		assert.Equal(t, results, response.News)
+	})
+
+	t.Run("Success_SortedByDate", func(t *testing.T) {
+		sort := models.NewsSort{{Field: models.NewsSortPublishedAt, Desc: true}, {Field: models.NewsSortRelevance, Desc: true}}
+
+		mockService := setupService(t)
+		mockService.On("SearchNews", "спорт", int64(10), int64(0), models.NewsFilter{}, sort).Return(results, nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/search", handler.SearchNews)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/search?q=%D1%81%D0%BF%D0%BE%D1%80%D1%82&sort=-published_at,-relevance", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("FailedEmptyQuery", func(t *testing.T) {
+		mockService := setupService(t)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/search", handler.SearchNews)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/search?q=%20%20", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+		mockService.AssertNotCalled(t, "SearchNews")
+	})
+}
+
+func ptr(value string) *string {
+	return &value
+}
+
+func TestCreateNewsBulk(t *testing.T) {
+	first := models.NewsCreateForm{Title: "First", Content: "First content"}
+	second := models.NewsCreateForm{Title: "Second", Content: "Second content"}
+	var firstID, secondID int64 = 11, 12
+
+	newApp := func(mockService *mocks.INewsService) *fiber.App {
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Post("/news/bulk", handler.CreateNewsBulk)
+		return app
+	}
+
+	send := func(app *fiber.App, url, body string) (int, BulkCreateResponse) {
+		req := httptest.NewRequest("POST", url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(editorHeader, "importer")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var response BulkCreateResponse
+		data, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(data, &response)
+		return resp.StatusCode, response
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("CreateNewsBulk", []models.NewsCreateForm{first, second}, 0, "importer", false).
+			Return([]models.BulkItemResult{{Index: 0, Id: &firstID}, {Index: 1, Id: &secondID}}, nil)
+
+		status, response := send(newApp(mockService), "/news/bulk",
+			`[{"Title": " First ", "Content": "First content"}, {"Title": "Second", "Content": "Second content"}]`)
+
+		assert.Equal(t, fiber.StatusCreated, status)
+		assert.True(t, response.Success)
+		assert.Equal(t, 2, response.Created)
+		assert.Equal(t, []models.BulkItemResult{{Index: 0, Id: &firstID}, {Index: 1, Id: &secondID}}, response.Results)
+	})
+
+	t.Run("SuccessPartial", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("CreateNewsBulk", []models.NewsCreateForm{second}, 1, "importer", false).
+			Return([]models.BulkItemResult{{Index: 0, Id: &secondID}}, nil)
+
+		status, response := send(newApp(mockService), "/news/bulk",
+			`[{"Title": 5, "Content": "First content"}, {"Title": "Second", "Content": "Second content"}]`)
+
+		assert.Equal(t, fiber.StatusMultiStatus, status)
+		assert.False(t, response.Success)
+		assert.Equal(t, 1, response.Created)
+		assert.Equal(t, []models.BulkItemResult{{Index: 0, Error: "Title: must be string"}, {Index: 1, Id: &secondID}}, response.Results)
+	})
+
+	t.Run("FailedAtomic", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("CreateNewsBulk", []models.NewsCreateForm{first}, 1, "importer", true).
+			Return([]models.BulkItemResult{{Index: 0, Error: "not created: batch rejected (atomic)"}}, nil)
+
+		status, response := send(newApp(mockService), "/news/bulk?atomic=true",
+			`[{"Title": "First", "Content": "First content"}, {"Title": "Second"}]`)
+
+		assert.Equal(t, fiber.StatusBadRequest, status)
+		assert.Equal(t, 0, response.Created)
+		assert.Equal(t, []models.BulkItemResult{
+			{Index: 0, Error: "not created: batch rejected (atomic)"},
+			{Index: 1, Error: "Content: field is required"},
+		}, response.Results)
+	})
+
+	invalidBodies := []struct {
+		name     string
+		body     string
+		errorMsg string
+	}{
+		{
+			name:     "not an array",
+			body:     `{"Title": "First", "Content": "First content"}`,
+			errorMsg: "body: must be a JSON array of news",
+		},
+		{
+			name:     "empty array",
+			body:     `[]`,
+			errorMsg: "body: must contain at least one news",
+		},
+	}
+
+	for _, ib := range invalidBodies {
+		t.Run(fmt.Sprintf("Failed_%s", ib.name), func(t *testing.T) {
+			mockService := setupService(t)
+			app := newApp(mockService)
+
+			resp, err := app.Test(httptest.NewRequest("POST", "/news/bulk", strings.NewReader(ib.body)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+			body, _ := io.ReadAll(resp.Body)
+			assert.Contains(t, string(body), ib.errorMsg)
+		})
+	}
+}
+
+func TestBulkEditNews(t *testing.T) {
+	newApp := func(mockService *mocks.INewsService) *fiber.App {
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Post("/news/bulk/edit", handler.BulkEditNews)
+		return app
+	}
+
+	send := func(app *fiber.App, url, body string) (int, string) {
+		req := httptest.NewRequest("POST", url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(editorHeader, "editor")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(data)
+	}
+
+	t.Run("SuccessByIds", func(t *testing.T) {
+		mockService := setupService(t)
+		editForm := models.NewsBulkEditForm{
+			Ids:        []int64{1, 2, 3},
+			Operation:  models.BulkAddCategories,
+			Categories: []int64{4, 5},
+			Editor:     "editor",
+		}
+		mockService.On("BulkEditNews", editForm, models.NewsFilter{}).Return(int64(2), nil)
+
+		status, body := send(newApp(mockService), "/news/bulk/edit",
+			`{"Ids": [3, 1, 2, 3], "Operation": "add_categories", "Categories": [5, 4]}`)
+
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.JSONEq(t, `{"Success": true, "Affected": 2}`, body)
+	})
+
+	t.Run("SuccessByFilter", func(t *testing.T) {
+		mockService := setupService(t)
+		editForm := models.NewsBulkEditForm{
+			Operation: models.BulkReplaceCategory,
+			From:      3,
+			To:        4,
+			Editor:    "editor",
+		}
+		filter := models.NewsFilter{CategoryIDs: []int64{3}, Statuses: []string{models.NewsStatusDraft}}
+		mockService.On("BulkEditNews", editForm, filter).Return(int64(7), nil)
+
+		status, body := send(newApp(mockService), "/news/bulk/edit?category=3&status=draft",
+			`{"Operation": "replace_category", "From": 3, "To": 4}`)
+
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.JSONEq(t, `{"Success": true, "Affected": 7}`, body)
+	})
+
+	invalidRequests := []struct {
+		name     string
+		url      string
+		body     string
+		errorMsg string
+	}{
+		{
+			name:     "unknown operation",
+			url:      "/news/bulk/edit",
+			body:     `{"Ids": [1], "Operation": "rename"}`,
+			errorMsg: "Operation: must be one of: add_categories, remove_categories, replace_category, set_status",
+		},
+		{
+			name:     "no categories",
+			url:      "/news/bulk/edit",
+			body:     `{"Ids": [1], "Operation": "remove_categories"}`,
+			errorMsg: "Categories: field is required",
+		},
+		{
+			name:     "replace with itself",
+			url:      "/news/bulk/edit",
+			body:     `{"Ids": [1], "Operation": "replace_category", "From": 2, "To": 2}`,
+			errorMsg: "To: must differ from From",
+		},
+		{
+			name:     "unknown status",
+			url:      "/news/bulk/edit",
+			body:     `{"Ids": [1], "Operation": "set_status", "Status": "deleted"}`,
+			errorMsg: "Status: must be one of: draft, review, published, archived",
+		},
+		{
+			name:     "ids and filter",
+			url:      "/news/bulk/edit?status=draft",
+			body:     `{"Ids": [1], "Operation": "set_status", "Status": "review"}`,
+			errorMsg: "Ids and filter cannot be used together",
+		},
+		{
+			name:     "no target",
+			url:      "/news/bulk/edit",
+			body:     `{"Operation": "set_status", "Status": "review"}`,
+			errorMsg: "Ids or a filter is required",
+		},
+		{
+			name:     "invalid filter",
+			url:      "/news/bulk/edit?status=deleted",
+			body:     `{"Operation": "set_status", "Status": "review"}`,
+			errorMsg: "status must be one of: draft, review, published, archived",
+		},
+	}
+
+	for _, ir := range invalidRequests {
+		t.Run(fmt.Sprintf("Failed_%s", ir.name), func(t *testing.T) {
+			mockService := setupService(t)
+
+			status, body := send(newApp(mockService), ir.url, ir.body)
+
+			assert.Equal(t, fiber.StatusBadRequest, status)
+			assert.Contains(t, body, ir.errorMsg)
+			mockService.AssertNotCalled(t, "BulkEditNews")
+		})
+	}
+}
+
+func TestNewsCategories(t *testing.T) {
+	news := models.NewsWithCategories{
+		News:       models.News{ID: 1, Title: "Title", Status: models.NewsStatusDraft, Version: 3},
+		Categories: []int64{1, 2},
+	}
+
+	newApp := func(mockService *mocks.INewsService) *fiber.App {
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Post("/news/:id/categories", handler.AddCategories)
+		app.Delete("/news/:id/categories/:categoryId", handler.RemoveCategory)
+		return app
+	}
+
+	send := func(app *fiber.App, method, url, body string) (int, string, string) {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(editorHeader, "editor")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, resp.Header.Get(fiber.HeaderETag), string(data)
+	}
+
+	t.Run("SuccessAdd", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("AddCategories", int64(1), []int64{2, 1}, "editor").Return(news, nil)
+
+		status, etag, body := send(newApp(mockService), "POST", "/news/1/categories", `{"Categories": [2, 1, 2]}`)
+
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.Equal(t, `"3"`, etag)
+
+		var response NewsResponse
+		json.Unmarshal([]byte(body), &response)
+		assert.True(t, response.Success)
+		assert.Equal(t, []int64{1, 2}, response.News.Categories)
+	})
+
+	t.Run("SuccessRemove", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("RemoveCategory", int64(1), int64(3), "editor").Return(news, nil)
+
+		status, _, _ := send(newApp(mockService), "DELETE", "/news/1/categories/3", "")
+
+		assert.Equal(t, fiber.StatusOK, status)
+	})
+
+	t.Run("FailedNotFound", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("RemoveCategory", int64(9), int64(3), "editor").
+			Return(models.NewsWithCategories{}, apperrors.NewNotFound("News not found"))
+
+		status, _, _ := send(newApp(mockService), "DELETE", "/news/9/categories/3", "")
+
+		assert.Equal(t, fiber.StatusNotFound, status)
+	})
+
+	invalidRequests := []struct {
+		name     string
+		method   string
+		url      string
+		body     string
+		errorMsg string
+	}{
+		{
+			name:     "empty categories",
+			method:   "POST",
+			url:      "/news/1/categories",
+			body:     `{"Categories": []}`,
+			errorMsg: "Categories: minimum length is 1",
+		},
+		{
+			name:     "missing categories",
+			method:   "POST",
+			url:      "/news/1/categories",
+			body:     `{}`,
+			errorMsg: "Categories: field is required",
+		},
+		{
+			name:     "negative category",
+			method:   "POST",
+			url:      "/news/1/categories",
+			body:     `{"Categories": [1, -2]}`,
+			errorMsg: "Categories[1]: must be greater than 0",
+		},
+		{
+			name:     "invalid category ID",
+			method:   "DELETE",
+			url:      "/news/1/categories/abc",
+			errorMsg: "Invalid category ID format",
+		},
+	}
+
+	for _, ir := range invalidRequests {
+		t.Run(fmt.Sprintf("Failed_%s", ir.name), func(t *testing.T) {
+			mockService := setupService(t)
+
+			status, _, body := send(newApp(mockService), ir.method, ir.url, ir.body)
+
+			assert.Equal(t, fiber.StatusBadRequest, status)
+			assert.Contains(t, body, ir.errorMsg)
+		})
+	}
+}
+
+func TestExportNews(t *testing.T) {
+	publishedAt := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	newsList := []models.NewsWithCategories{
+		{
+			News: models.News{
+				ID: 1, Title: "First", Content: "Line one\nLine two", ContentFormat: models.ContentFormatMarkdown, Status: models.NewsStatusPublished,
+				CreatedAt: publishedAt, UpdatedAt: publishedAt, PublishedAt: &publishedAt, Version: 2,
+			},
+			Categories: []int64{1, 2},
+		},
+		{
+			News: models.News{
+				ID: 2, Title: "Second, with comma", Content: "Content", ContentFormat: models.ContentFormatPlain, Status: models.NewsStatusPublished,
+				CreatedAt: publishedAt, UpdatedAt: publishedAt, PublishedAt: &publishedAt, Version: 1,
+			},
+			Categories: []int64{},
+		},
+	}
+
+	newApp := func(mockService *mocks.INewsService, filter models.NewsFilter) *fiber.App {
+		mockService.On("ExportNews", mock.Anything, filter, mock.Anything).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(2).(func(models.NewsWithCategories) error)
+				for _, news := range newsList {
+					assert.NoError(t, fn(news))
+				}
+			}).
+			Return(nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/export", handler.ExportNews)
+		return app
+	}
+
+	t.Run("SuccessNDJSON", func(t *testing.T) {
+		mockService := setupService(t)
+		app := newApp(mockService, models.NewsFilter{CategoryIDs: []int64{1}})
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/export?category=1", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/x-ndjson", resp.Header.Get(fiber.HeaderContentType))
+		assert.Regexp(t, `^attachment; filename="news-\d{8}-\d{6}\.ndjson"$`, resp.Header.Get(fiber.HeaderContentDisposition))
+
+		body, _ := io.ReadAll(resp.Body)
+		lines := strings.Split(strings.TrimSuffix(string(body), "\n"), "\n")
+		assert.Len(t, lines, 2)
+
+		var first models.NewsWithCategories
+		assert.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+		assert.Equal(t, int64(1), first.ID)
+		assert.Equal(t, "Line one\nLine two", first.Content)
+		assert.Equal(t, []int64{1, 2}, first.Categories)
+	})
+
+	t.Run("SuccessCSV", func(t *testing.T) {
+		mockService := setupService(t)
+		app := newApp(mockService, models.NewsFilter{})
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/export?format=csv", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get(fiber.HeaderContentType))
+		assert.Regexp(t, `filename="news-\d{8}-\d{6}\.csv"$`, resp.Header.Get(fiber.HeaderContentDisposition))
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, "Id,Title,Content,ContentFormat,Status,PublishAt,ExpiresAt,CreatedAt,UpdatedAt,PublishedAt,Version,Categories\n"+
+			"1,First,\"Line one\nLine two\",markdown,published,,,2025-12-20T10:00:00Z,2025-12-20T10:00:00Z,2025-12-20T10:00:00Z,2,1;2\n"+
+			"2,\"Second, with comma\",Content,plain,published,,,2025-12-20T10:00:00Z,2025-12-20T10:00:00Z,2025-12-20T10:00:00Z,1,\n",
+			string(body))
+	})
+
+	t.Run("SuccessManyRows", func(t *testing.T) {
+		mockService := setupService(t)
+		rows := 2*exportFlushRows + 1
+
+		var exportCtx context.Context
+		mockService.On("ExportNews", mock.Anything, models.NewsFilter{}, mock.Anything).
+			Run(func(args mock.Arguments) {
+				exportCtx = args.Get(0).(context.Context)
+				fn := args.Get(2).(func(models.NewsWithCategories) error)
+				for i := 1; i <= rows; i++ {
+					news := newsList[1]
+					news.ID = int64(i)
+					assert.NoError(t, fn(news))
+				}
+			}).
+			Return(nil)
+
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New()
+		app.Get("/export", handler.ExportNews)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/export", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		lines := strings.Split(strings.TrimSuffix(string(body), "\n"), "\n")
+		assert.Len(t, lines, rows)
+		assert.Contains(t, lines[rows-1], fmt.Sprintf(`"Id":%d`, rows))
+		assert.ErrorIs(t, exportCtx.Err(), context.Canceled)
+	})
+
+	t.Run("FailedFormat", func(t *testing.T) {
+		mockService := setupService(t)
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/export", handler.ExportNews)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/export?format=xml", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "format must be one of: ndjson, csv")
+		mockService.AssertNotCalled(t, "ExportNews")
+	})
+}
+
+func TestImportNews(t *testing.T) {
+	var firstID, secondID int64 = 21, 22
+
+	newApp := func(mockService *mocks.INewsService) *fiber.App {
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Post("/import", handler.ImportNews)
+		return app
+	}
+
+	send := func(app *fiber.App, req *http.Request) (int, ImportResponse, string) {
+		req.Header.Set(editorHeader, "migration")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, _ := io.ReadAll(resp.Body)
+		var response ImportResponse
+		json.Unmarshal(data, &response)
+		return resp.StatusCode, response, string(data)
+	}
+
+	upload := func(url, filename, content string) *http.Request {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		part, err := writer.CreateFormFile("file", filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write([]byte(content))
+		writer.Close()
+
+		req := httptest.NewRequest("POST", url, &body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return req
+	}
+
+	t.Run("SuccessNDJSON", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("ImportNews", []models.NewsCreateForm{
+			{Title: "First", Content: "First content", Categories: &[]int64{1}},
+			{Title: "Second", Content: "Second content"},
+		}, "migration", false).Return([]models.BulkItemResult{{Index: 0, Id: &firstID}, {Index: 1, Id: &secondID}}, nil)
+
+		body := `{"Title": "First", "Content": "First content", "Categories": [1, 1]}` + "\n" +
+			`{"Title": 5, "Content": "Broken"}` + "\n" +
+			"\n" +
+			`{"Id": 7, "Title": "Second", "Content": "Second content", "Status": "published"}` + "\n"
+		req := httptest.NewRequest("POST", "/import", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/x-ndjson")
+
+		status, response, _ := send(newApp(mockService), req)
+
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.False(t, response.Success)
+		assert.False(t, response.DryRun)
+		assert.Equal(t, 2, response.Accepted)
+		assert.Equal(t, 1, response.Rejected)
+		assert.Equal(t, []models.ImportRowResult{
+			{Line: 1, Id: &firstID},
+			{Line: 2, Error: "Title: must be string"},
+			{Line: 4, Id: &secondID},
+		}, response.Rows)
+	})
+
+	t.Run("SuccessCSVDryRun", func(t *testing.T) {
+		mockService := setupService(t)
+		publishAt := time.Date(2025, 12, 21, 6, 0, 0, 0, time.UTC)
+		mockService.On("ImportNews", []models.NewsCreateForm{
+			{Title: "First", Content: "Multi\nline", ContentFormat: models.ContentFormatMarkdown, Categories: &[]int64{1, 2}, PublishAt: &publishAt},
+			{Title: "Unknown category", Content: "Content", Categories: &[]int64{9}},
+		}, "migration", true).Return([]models.BulkItemResult{
+			{Index: 0},
+			{Index: 1, Error: "Categories: unknown category IDs: 9"},
+		}, nil)
+
+		content := "Id,Title,Content,ContentFormat,Categories,PublishAt\n" +
+			"1,First,\"Multi\nline\",Markdown,1;2,2025-12-21T06:00:00Z\n" +
+			"2,Bad categories,Content,,1;x,\n" +
+			"3,Unknown category,Content,,9,\n" +
+			"4,No content,,,,\n" +
+			"5,Too few\n"
+
+		status, response, _ := send(newApp(mockService), upload("/import?dry_run=true", "news.csv", content))
+
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.True(t, response.DryRun)
+		assert.Equal(t, 1, response.Accepted)
+		assert.Equal(t, 4, response.Rejected)
+		assert.Equal(t, []models.ImportRowResult{
+			{Line: 2},
+			{Line: 4, Error: `Categories: must be IDs separated by ";"`},
+			{Line: 5, Error: "Categories: unknown category IDs: 9"},
+			{Line: 6, Error: "Content: field is required"},
+			{Line: 7, Error: "record: wrong number of fields"},
+		}, response.Rows)
+	})
+
+	t.Run("SuccessBatchRejected", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("ImportNews", mock.Anything, "migration", false).
+			Return(nil, apperrors.NewValidation("Categories: unknown category IDs"))
+
+		req := httptest.NewRequest("POST", "/import", strings.NewReader(`{"Title": "First", "Content": "Content", "Categories": [3]}`))
+
+		status, response, _ := send(newApp(mockService), req)
+
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.Equal(t, 0, response.Accepted)
+		assert.Equal(t, []models.ImportRowResult{{Line: 1, Error: "Categories: unknown category IDs"}}, response.Rows)
+	})
+
+	t.Run("FailedService", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("ImportNews", mock.Anything, "migration", false).
+			Return(nil, apperrors.NewInternal("internal error"))
+
+		req := httptest.NewRequest("POST", "/import", strings.NewReader(`{"Title": "First", "Content": "Content"}`))
+
+		status, _, _ := send(newApp(mockService), req)
+
+		assert.Equal(t, fiber.StatusInternalServerError, status)
+	})
+
+	t.Run("FailedServiceAfterCreatedBatch", func(t *testing.T) {
+		mockService := setupService(t)
+		created := make([]models.BulkItemResult, importBatchSize)
+		for i := range created {
+			id := int64(i + 1)
+			created[i] = models.BulkItemResult{Index: i, Id: &id}
+		}
+		mockService.On("ImportNews", mock.Anything, "migration", false).Return(created, nil).Once()
+		mockService.On("ImportNews", mock.Anything, "migration", false).
+			Return(nil, fmt.Errorf("failed to create news: connection reset")).Once()
+
+		var body strings.Builder
+		for i := 0; i < importBatchSize+2; i++ {
+			fmt.Fprintf(&body, `{"Title": "News %d", "Content": "Content"}`+"\n", i)
+		}
+		req := httptest.NewRequest("POST", "/import", strings.NewReader(body.String()))
+
+		status, response, _ := send(newApp(mockService), req)
+
+		assert.Equal(t, fiber.StatusInternalServerError, status)
+		assert.False(t, response.Success)
+		assert.Equal(t, "Internal server error", response.Error)
+		assert.Equal(t, importBatchSize, response.Accepted)
+		assert.Equal(t, 2, response.Rejected)
+		assert.Len(t, response.Rows, importBatchSize+2)
+		assert.Equal(t, models.ImportRowResult{Line: 1, Id: created[0].Id}, response.Rows[0])
+		assert.Equal(t, models.ImportRowResult{Line: importBatchSize + 2, Error: "not imported: Internal server error"}, response.Rows[importBatchSize+1])
+		mockService.AssertNumberOfCalls(t, "ImportNews", 2)
+	})
+
+	t.Run("FailedFileAfterCreatedBatch", func(t *testing.T) {
+		mockService := setupService(t)
+		created := make([]models.BulkItemResult, importBatchSize)
+		for i := range created {
+			id := int64(i + 1)
+			created[i] = models.BulkItemResult{Index: i, Id: &id}
+		}
+		mockService.On("ImportNews", mock.Anything, "migration", false).Return(created, nil).Once()
+
+		var body strings.Builder
+		body.WriteString("Title,Content\n")
+		for i := 0; i < importBatchSize+1; i++ {
+			fmt.Fprintf(&body, "News %d,Content\n", i)
+		}
+		body.WriteString("\"Broken,Content\n")
+
+		status, response, _ := send(newApp(mockService), upload("/import", "news.csv", body.String()))
+
+		assert.Equal(t, fiber.StatusBadRequest, status)
+		assert.Contains(t, response.Error, "invalid CSV")
+		assert.Equal(t, importBatchSize, response.Accepted)
+		assert.Equal(t, 1, response.Rejected)
+		assert.Equal(t, importBatchSize+2, response.Rows[importBatchSize].Line)
+		assert.Contains(t, response.Rows[importBatchSize].Error, "not imported: invalid CSV")
+		mockService.AssertNumberOfCalls(t, "ImportNews", 1)
+	})
+
+	invalidRequests := []struct {
+		name     string
+		req      func() *http.Request
+		errorMsg string
+	}{
+		{
+			name: "csv without content column",
+			req: func() *http.Request {
+				return upload("/import", "news.csv", "Title,Body\nFirst,Content\n")
+			},
+			errorMsg: "CSV header must contain a Content column",
+		},
+		{
+			name: "unknown format",
+			req: func() *http.Request {
+				return httptest.NewRequest("POST", "/import?format=xml", strings.NewReader("<news/>"))
+			},
+			errorMsg: "format must be one of: ndjson, csv",
+		},
+		{
+			name: "invalid dry_run",
+			req: func() *http.Request {
+				return httptest.NewRequest("POST", "/import?dry_run=maybe", strings.NewReader(""))
+			},
+			errorMsg: "dry_run must be a boolean",
+		},
+	}
+
+	for _, ir := range invalidRequests {
+		t.Run(fmt.Sprintf("Failed_%s", ir.name), func(t *testing.T) {
+			mockService := setupService(t)
+
+			status, _, body := send(newApp(mockService), ir.req())
+
+			assert.Equal(t, fiber.StatusBadRequest, status)
+			assert.Contains(t, body, ir.errorMsg)
+			mockService.AssertNotCalled(t, "ImportNews")
+		})
+	}
+}
+
+func TestTranslations(t *testing.T) {
+	var newsId int64 = 7
+
+	newApp := func(mockService *mocks.INewsService) *fiber.App {
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/list", handler.ListNews)
+		app.Get("/news/:id", handler.GetNews)
+		app.Post("/edit/:id", handler.EditNews)
+		app.Get("/news/:id/translations", handler.ListTranslations)
+		app.Delete("/news/:id/translations/:locale", handler.DeleteTranslation)
+		app.Get("/translations/completeness", handler.TranslationCompleteness)
+		return app
+	}
+
+	translate := func(args mock.Arguments) {
+		news := args.Get(1).([]models.NewsWithCategories)
+		for i := range news {
+			news[i].Title, news[i].Content, news[i].Locale = "Weather", "**Sunny**", "en"
+		}
+	}
+
+	t.Run("Success_GetNewsWithLang", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetNewsByID", newsId).Return(models.NewsWithCategories{
+			News: models.News{ID: newsId, Title: "Погода", Content: "**Солнечно**", ContentFormat: models.ContentFormatMarkdown},
+		}, nil)
+		mockService.On("LocalizeNews", "en", mock.Anything).Run(translate).Return(nil)
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d?lang=EN&html=true", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response NewsResponse
+		json.Unmarshal(body, &response)
+
+		assert.Equal(t, "Weather", response.News.Title)
+		assert.Equal(t, "en", response.News.Locale)
+		if assert.NotNil(t, response.News.ContentHTML) {
+			assert.Equal(t, "<p><strong>Sunny</strong></p>\n", *response.News.ContentHTML)
+		}
+	})
+
+	t.Run("Success_ListNewsWithLang", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("ListNews", int64(10), int64(0), (*models.NewsCursor)(nil), models.CountExact, models.NewsFilter{}, models.DefaultNewsSort).
+			Return(models.NewsPage{News: []models.NewsWithCategories{{News: models.News{ID: newsId, Title: "Погода"}}}}, nil)
+		mockService.On("LocalizeNews", "en", mock.Anything).Run(translate).Return(nil)
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("GET", "/list?lang=en", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response NewsListsResponse
+		json.Unmarshal(body, &response)
+
+		if assert.Len(t, response.News, 1) {
+			assert.Equal(t, "Weather", response.News[0].Title)
+			assert.Equal(t, "en", response.News[0].Locale)
+		}
+	})
+
+	t.Run("Success_GetNewsWithoutLang", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetNewsByID", newsId).Return(models.NewsWithCategories{News: models.News{ID: newsId, Title: "Погода"}}, nil)
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+		assert.NotContains(t, string(body), `"Locale"`)
+		mockService.AssertNotCalled(t, "LocalizeNews", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success_EditTranslation", func(t *testing.T) {
+		mockService := setupService(t)
+		title := "Weather"
+		mockService.On("EditNews", newsId, models.NewsEditForm{Title: &title, Locale: "en"}).Return(nil)
+
+		req := httptest.NewRequest("POST", fmt.Sprintf("/edit/%d?lang=en", newsId), strings.NewReader(`{"Title": "Weather"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := newApp(mockService).Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Success_ListTranslations", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("ListTranslations", newsId).Return(models.NewsTranslations{
+			NewsId:        newsId,
+			DefaultLocale: "ru",
+			Translations:  []models.NewsTranslation{{NewsId: newsId, Locale: "en", Title: "Weather", Content: "Sunny"}},
+			Missing:       []string{"de"},
+		}, nil)
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d/translations", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response TranslationsResponse
+		json.Unmarshal(body, &response)
+
+		assert.True(t, response.Success)
+		assert.Equal(t, "ru", response.DefaultLocale)
+		assert.Len(t, response.Translations, 1)
+		assert.Equal(t, []string{"de"}, response.Missing)
+	})
+
+	t.Run("Success_DeleteTranslation", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("DeleteTranslation", newsId, "en").Return(nil)
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("DELETE", fmt.Sprintf("/news/%d/translations/EN", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Success_Completeness", func(t *testing.T) {
+		mockService := setupService(t)
+		report := []models.TranslationCompleteness{
+			{Locale: "ru", Translated: 3, Total: 3, Percent: 100},
+			{Locale: "en", Translated: 2, Total: 3, Percent: 66.7},
+		}
+		mockService.On("TranslationCompleteness", models.NewsFilter{Statuses: []string{"published", "archived"}}).Return(report, nil)
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("GET", "/translations/completeness?status=published,archived", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response TranslationCompletenessResponse
+		json.Unmarshal(body, &response)
+
+		assert.Equal(t, report, response.Locales)
+	})
+
+	t.Run("FailedUnknownLang", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("GetNewsByID", newsId).Return(models.NewsWithCategories{News: models.News{ID: newsId}}, nil)
+		mockService.On("LocalizeNews", "fr", mock.Anything).Return(apperrors.NewBadRequest("lang must be one of: ru, en"))
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d?lang=fr", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "lang must be one of: ru, en")
+	})
+
+	t.Run("FailedTranslationNotFound", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("DeleteTranslation", newsId, "de").Return(apperrors.NewTranslationNotFound("Translation not found"))
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("DELETE", fmt.Sprintf("/news/%d/translations/de", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestRelatedNews(t *testing.T) {
+	var newsId int64 = 7
+
+	newApp := func(mockService *mocks.INewsService) *fiber.App {
+		handler := NewNewsHandler(mockService, testLogger)
+		app := fiber.New(fiber.Config{
+			ErrorHandler: errors.ErrorHandler(testLogger),
+		})
+		app.Get("/news/:id/related", handler.RelatedNews)
+		return app
+	}
+
+	related := func() []models.RelatedNews {
+		return []models.RelatedNews{
+			{NewsWithCategories: models.NewsWithCategories{News: models.News{ID: 8, Title: "Погода", Content: "**Солнечно**",
+				ContentFormat: models.ContentFormatMarkdown}, Categories: []int64{1}}, Score: 0.8},
+			{NewsWithCategories: models.NewsWithCategories{News: models.News{ID: 9, Title: "Спорт"}}, Score: 0.4},
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("RelatedNews", newsId, int64(0)).Return(related(), nil)
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d/related", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response RelatedResponse
+		json.Unmarshal(body, &response)
+
+		assert.True(t, response.Success)
+		if assert.Len(t, response.News, 2) {
+			assert.Equal(t, int64(8), response.News[0].ID)
+			assert.Equal(t, 0.8, response.News[0].Score)
+			assert.Equal(t, []int64{1}, response.News[0].Categories)
+			assert.Nil(t, response.News[0].ContentHTML)
+		}
+		mockService.AssertNotCalled(t, "LocalizeNews", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success_WithLimitLangAndHTML", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("RelatedNews", newsId, int64(2)).Return(related(), nil)
+		mockService.On("LocalizeNews", "en", mock.Anything).Run(func(args mock.Arguments) {
+			news := args.Get(1).([]models.NewsWithCategories)
+			news[0].Title, news[0].Content, news[0].Locale = "Weather", "**Sunny**", "en"
+		}).Return(nil)
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d/related?limit=2&lang=en&html=true", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var response RelatedResponse
+		json.Unmarshal(body, &response)
+
+		if assert.Len(t, response.News, 2) {
+			assert.Equal(t, "Weather", response.News[0].Title)
+			assert.Equal(t, "en", response.News[0].Locale)
+			assert.Equal(t, 0.8, response.News[0].Score)
+			if assert.NotNil(t, response.News[0].ContentHTML) {
+				assert.Equal(t, "<p><strong>Sunny</strong></p>\n", *response.News[0].ContentHTML)
+			}
+		}
+	})
+
+	t.Run("Success_Empty", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("RelatedNews", newsId, int64(0)).Return([]models.RelatedNews{}, nil)
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d/related", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), `"News":[]`)
+	})
+
+	invalidRequests := []struct {
+		name     string
+		url      string
+		errorMsg string
+	}{
+		{"Invalid ID", "/news/abc/related", "Invalid ID format"},
+		{"Limit is not a number", fmt.Sprintf("/news/%d/related?limit=many", newsId), "limit must be a valid number"},
+		{"Limit is zero", fmt.Sprintf("/news/%d/related?limit=0", newsId), "limit must be between 1 and 50"},
+		{"Limit too large", fmt.Sprintf("/news/%d/related?limit=51", newsId), "limit must be between 1 and 50"},
+	}
+
+	for _, ir := range invalidRequests {
+		t.Run("Failed_"+ir.name, func(t *testing.T) {
+			mockService := setupService(t)
+
+			resp, err := newApp(mockService).Test(httptest.NewRequest("GET", ir.url, nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+			body, _ := io.ReadAll(resp.Body)
+			assert.Contains(t, string(body), ir.errorMsg)
+			mockService.AssertNotCalled(t, "RelatedNews", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("FailedNotFound", func(t *testing.T) {
+		mockService := setupService(t)
+		mockService.On("RelatedNews", newsId, int64(0)).Return(nil, apperrors.NewNotFound("News not found"))
+
+		resp, err := newApp(mockService).Test(httptest.NewRequest("GET", fmt.Sprintf("/news/%d/related", newsId), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"service/internal/apperrors"
+	"service/internal/handlers/request"
+	"service/internal/models"
+	"service/internal/validators"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RelatedResponse struct {
+	Success bool                 `json:"Success" example:"true"`
+	News    []models.RelatedNews `json:"News"`
+}
+
+// RelatedNews godoc
+// @Summary Related news
+// @Description Published news related to news, for a "read also" block, best scored first.
+// @Description The score is the weighted sum of the share of categories the news have in common, the trigram similarity of titles and the recency, which halves every configured number of days
+// @Tags news
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Param limit query int false "default=NEWS_RELATED_LIMIT, max=50"
+// @Param html query bool false "Add ContentHTML, the content rendered from its ContentFormat as sanitised HTML"
+// @Param lang query string false "Locale of Title and Content; without a translation falls back to the fallback locales, then the default one"
+// @Success 200 {object} RelatedResponse "Related news"
+// @Failure 400 {object} ErrorResponse "Invalid ID or limit"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/related [get]
+func (h *NewsHandler) RelatedNews(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	var limit int64
+	if value := c.Query("limit"); value != "" {
+		if limit, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return apperrors.NewBadRequest("limit must be a valid number")
+		}
+		if err = validators.ValidateRelatedLimit(limit); err != nil {
+			return err
+		}
+	}
+
+	includeHTML, err := parseIncludeHTML(c)
+	if err != nil {
+		return err
+	}
+
+	related, err := h.service.RelatedNews(id, limit)
+	if err != nil {
+		return err
+	}
+
+	news := make([]models.NewsWithCategories, len(related))
+	for i := range related {
+		news[i] = related[i].NewsWithCategories
+	}
+	if err = h.localize(c, news); err != nil {
+		return err
+	}
+
+	for i := range related {
+		related[i].NewsWithCategories = news[i]
+		if includeHTML {
+			if err = related[i].RenderHTML(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(RelatedResponse{Success: true, News: related})
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"service/internal/apperrors"
+	"service/internal/handlers/request"
+	"service/internal/models"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RevisionListResponse struct {
+	Success   bool                  `json:"Success" example:"true"`
+	Revisions []models.NewsRevision `json:"Revisions"`
+}
+
+type RevisionResponse struct {
+	Success  bool                `json:"Success" example:"true"`
+	Revision models.NewsRevision `json:"Revision"`
+}
+
+type RevisionDiffResponse struct {
+	Success bool                    `json:"Success" example:"true"`
+	Diff    models.NewsRevisionDiff `json:"Diff"`
+}
+
+// ListRevisions godoc
+// @Summary Get news revisions
+// @Description Get all revisions of news, newest first
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Success 200 {object} RevisionListResponse "List revisions"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/revisions [get]
+func (h *NewsHandler) ListRevisions(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	revisions, err := h.service.ListRevisions(id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(RevisionListResponse{Success: true, Revisions: revisions})
+}
+
+// GetRevision godoc
+// @Summary Get news revision
+// @Description Get a single revision of news
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} RevisionResponse "Revision"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "Revision not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/revisions/{rev} [get]
+func (h *NewsHandler) GetRevision(c *fiber.Ctx) error {
+	id, rev, err := parseRevisionParams(c)
+	if err != nil {
+		return err
+	}
+
+	revision, err := h.service.GetRevision(id, rev)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(RevisionResponse{Success: true, Revision: revision})
+}
+
+// DiffRevisions godoc
+// @Summary Diff news revisions
+// @Description Get a line-level diff of the content between two revisions
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Param from query int true "Revision to diff from"
+// @Param to query int true "Revision to diff to"
+// @Success 200 {object} RevisionDiffResponse "Diff"
+// @Failure 400 {object} ErrorResponse "Error validation params"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "Revision not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/revisions/diff [get]
+func (h *NewsHandler) DiffRevisions(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	from, err := parseRevisionNumber(c.Query("from"), "from")
+	if err != nil {
+		return err
+	}
+
+	to, err := parseRevisionNumber(c.Query("to"), "to")
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.DiffRevisions(id, from, to)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(RevisionDiffResponse{Success: true, Diff: result})
+}
+
+// RestoreRevision godoc
+// @Summary Restore news revision
+// @Description Roll news back to a revision. The restored state is saved as a new revision
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Param rev path int true "Revision number"
+// @Param X-Editor header string false "Editor recorded in the revision"
+// @Success 200 {object} SuccessResponse "Revision restored"
+// @Failure 400 {object} ErrorResponse "Invalid ID or category of the revision no longer exists"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "Revision not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/revisions/{rev}/restore [post]
+func (h *NewsHandler) RestoreRevision(c *fiber.Ctx) error {
+	id, rev, err := parseRevisionParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err = h.service.RestoreRevision(id, rev, c.Get(editorHeader)); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SuccessResponse{
+		Success: true,
+	})
+}
+
+func parseRevisionParams(c *fiber.Ctx) (int64, int64, error) {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	rev, err := parseRevisionNumber(c.Params("rev"), "rev")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return id, rev, nil
+}
+
+func parseRevisionNumber(value, name string) (int64, error) {
+	rev, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || rev < 1 {
+		return 0, apperrors.NewBadRequest(name + " must be a positive revision number")
+	}
+
+	return rev, nil
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"service/internal/handlers/request"
+	"service/internal/models"
+	"service/internal/validators"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SearchResponse struct {
+	Success bool                      `json:"Success" example:"true"`
+	News    []models.NewsSearchResult `json:"News"`
+}
+
+// SearchNews godoc
+// @Summary Search news
+// @Description Full-text search over title and content with Russian and English morphology. Matches in the title rank higher.
+// @Description The query supports web search syntax: "quoted phrases", OR and -excluded words. Highlights are HTML-escaped text with matches wrapped in <b>
+// @Tags news
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query, max 200 characters"
+// @Param limit query int false "default=10, max=100"
+// @Param offset query int false "default=0"
+// @Param category query string false "Comma-separated category IDs, example: 1,2"
+// @Param category_mode query string false "any (default) - news tagged with any of category, all - with all of them" Enums(any, all)
+// @Param exclude_category query string false "Comma-separated category IDs to exclude"
+// @Param include_descendants query bool false "Also match subcategories of category and exclude_category"
+// @Param status query string false "Comma-separated statuses (draft, review, published, archived), default=published"
+// @Param created_from query string false "Created at or after: date (2025-12-20) or RFC 3339 date-time"
+// @Param created_to query string false "Created at or before: date (inclusive) or RFC 3339 date-time"
+// @Param title_contains query string false "Case-insensitive substring of the title"
+// @Param sort query string false "Comma-separated sort fields (id, title, created_at, updated_at, published_at, relevance), prefix with - for descending, default=-relevance"
+// @Param html query bool false "Add ContentHTML, the content rendered from its ContentFormat as sanitised HTML"
+// @Success 200 {object} SearchResponse "Ranked news"
+// @Failure 400 {object} ErrorResponse "Error validation params"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /search [get]
+func (h *NewsHandler) SearchNews(c *fiber.Ctx) error {
+	query := strings.TrimSpace(c.Query("q"))
+	if err := validators.ValidateSearchQuery(query); err != nil {
+		return err
+	}
+
+	limit, offset, err := request.ParsePagination(c)
+	if err != nil {
+		return err
+	}
+
+	filter, err := parseNewsFilter(c)
+	if err != nil {
+		return err
+	}
+
+	sort, err := parseNewsSort(c.Query("sort"), models.SearchSortFields, models.DefaultSearchSort)
+	if err != nil {
+		return err
+	}
+
+	includeHTML, err := parseIncludeHTML(c)
+	if err != nil {
+		return err
+	}
+
+	results, err := h.service.SearchNews(query, limit, offset, filter, sort)
+	if err != nil {
+		return err
+	}
+
+	if includeHTML {
+		for i := range results {
+			if err = results[i].RenderHTML(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SearchResponse{Success: true, News: results})
+}
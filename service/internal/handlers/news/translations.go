@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"service/internal/handlers/request"
+	"service/internal/models"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TranslationsResponse struct {
+	Success bool `json:"Success" example:"true"`
+	models.NewsTranslations
+}
+
+type TranslationCompletenessResponse struct {
+	Success bool                             `json:"Success" example:"true"`
+	Locales []models.TranslationCompleteness `json:"Locales"`
+}
+
+// ListTranslations godoc
+// @Summary Get news translations
+// @Description Get the translations of news to the configured locales and the locales it is not translated to yet
+// @Description Translations are added and edited with POST /edit/{id}?lang={locale}
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Success 200 {object} TranslationsResponse "Translations"
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/translations [get]
+func (h *NewsHandler) ListTranslations(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	translations, err := h.service.ListTranslations(id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(TranslationsResponse{Success: true, NewsTranslations: translations})
+}
+
+// DeleteTranslation godoc
+// @Summary Delete news translation
+// @Description Delete the translation of news to a locale; news is then shown in the fallback locales
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param id path int true "ID news"
+// @Param locale path string true "Locale, example: en"
+// @Success 200 {object} SuccessResponse "Translation deleted"
+// @Failure 400 {object} ErrorResponse "Invalid ID, unknown or default locale"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 404 {object} ErrorResponse "News or translation not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /news/{id}/translations/{locale} [delete]
+func (h *NewsHandler) DeleteTranslation(c *fiber.Ctx) error {
+	id, err := request.ParseID(c)
+	if err != nil {
+		return err
+	}
+
+	if err = h.service.DeleteTranslation(id, normalizeLocale(c.Params("locale"))); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SuccessResponse{
+		Success: true,
+	})
+}
+
+// TranslationCompleteness godoc
+// @Summary Translation completeness
+// @Description For each configured locale, the number and percent of news matching the filters that are translated to it. The default locale is always complete
+// @Description Takes the filters of GET /list; only published news is counted unless status filter is set
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param category query string false "Comma-separated category IDs, example: 1,2"
+// @Param category_mode query string false "any (default) - news tagged with any of category, all - with all of them" Enums(any, all)
+// @Param exclude_category query string false "Comma-separated category IDs to exclude"
+// @Param include_descendants query bool false "Also match subcategories of category and exclude_category"
+// @Param status query string false "Comma-separated statuses (draft, review, published, archived), default=published"
+// @Param created_from query string false "Created at or after: date (2025-12-20) or RFC 3339 date-time"
+// @Param created_to query string false "Created at or before: date (inclusive) or RFC 3339 date-time"
+// @Param title_contains query string false "Case-insensitive substring of the title"
+// @Success 200 {object} TranslationCompletenessResponse "Completeness by locale"
+// @Failure 400 {object} ErrorResponse "Error validation params"
+// @Failure 401 {object} ErrorResponse "Not authorized"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Security BearerAuth
+// @Router /translations/completeness [get]
+func (h *NewsHandler) TranslationCompleteness(c *fiber.Ctx) error {
+	filter, err := parseNewsFilter(c)
+	if err != nil {
+		return err
+	}
+
+	report, err := h.service.TranslationCompleteness(filter)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(TranslationCompletenessResponse{Success: true, Locales: report})
+}
+
+// localize shows news in the locale of the lang query parameter, when it is given.
+func (h *NewsHandler) localize(c *fiber.Ctx, news []models.NewsWithCategories) error {
+	lang := normalizeLocale(c.Query("lang"))
+	if lang == "" {
+		return nil
+	}
+
+	return h.service.LocalizeNews(lang, news)
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.TrimSpace(locale))
+}
@@ -0,0 +1,23 @@
+package request
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// NullFields reports the top-level fields of a JSON object body that are sent as null. Parsed into a form,
+// they are nil pointers just like fields left out, so partial updates use it to tell "clear" from "keep".
+func NullFields(body []byte) map[string]bool {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil
+	}
+
+	nulls := make(map[string]bool)
+	for field, value := range raw {
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			nulls[field] = true
+		}
+	}
+	return nulls
+}
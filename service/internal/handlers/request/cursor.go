@@ -0,0 +1,40 @@
+package request
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"service/internal/apperrors"
+	"service/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// EncodeCursor renders a cursor as an opaque URL-safe token.
+func EncodeCursor(cursor *models.NewsCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseCursor decodes the cursor query parameter, or returns nil when it is absent.
+func ParseCursor(c *fiber.Ctx) (*models.NewsCursor, error) {
+	token := c.Query("cursor")
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, apperrors.NewBadRequest("cursor is malformed")
+	}
+
+	var cursor models.NewsCursor
+	if err = json.Unmarshal(data, &cursor); err != nil {
+		return nil, apperrors.NewBadRequest("cursor is malformed")
+	}
+
+	return &cursor, nil
+}
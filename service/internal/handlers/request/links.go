@@ -0,0 +1,54 @@
+package request
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PageLinks holds the URLs of the RFC 8288 pagination links of a list, empty when the page does not exist.
+type PageLinks struct {
+	First string
+	Prev  string
+	Next  string
+	Last  string
+}
+
+// SetLinkHeader writes the non-empty links into the Link header.
+func SetLinkHeader(c *fiber.Ctx, links PageLinks) {
+	parts := make([]string, 0, 4)
+	for _, link := range []struct{ rel, url string }{
+		{"first", links.First},
+		{"prev", links.Prev},
+		{"next", links.Next},
+		{"last", links.Last},
+	} {
+		if link.url != "" {
+			parts = append(parts, "<"+link.url+`>; rel="`+link.rel+`"`)
+		}
+	}
+
+	if len(parts) > 0 {
+		c.Set(fiber.HeaderLink, strings.Join(parts, ", "))
+	}
+}
+
+// PageURL returns the request URL with query parameters replaced by params. An empty value removes the parameter.
+func PageURL(c *fiber.Ctx, params map[string]string) string {
+	query, _ := url.ParseQuery(string(c.Request().URI().QueryString()))
+	for name, value := range params {
+		if value == "" {
+			query.Del(name)
+		} else {
+			query.Set(name, value)
+		}
+	}
+
+	pageURL := c.BaseURL() + c.Path()
+	if encoded := query.Encode(); encoded != "" {
+		pageURL += "?" + encoded
+	}
+
+	return pageURL
+}
@@ -0,0 +1,36 @@
+package request
+
+import (
+	"service/internal/apperrors"
+	"service/internal/validators"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ParseID(c *fiber.Ctx) (int64, error) {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return 0, apperrors.NewBadRequest("Invalid ID format")
+	}
+
+	return int64(id), nil
+}
+
+func ParsePagination(c *fiber.Ctx) (int64, int64, error) {
+	limit, err := strconv.ParseInt(c.Query("limit", "10"), 10, 64)
+	if err != nil {
+		return 0, 0, apperrors.NewBadRequest("limit must be a valid number")
+	}
+
+	offset, err := strconv.ParseInt(c.Query("offset", "0"), 10, 64)
+	if err != nil {
+		return 0, 0, apperrors.NewBadRequest("offset must be a valid number")
+	}
+
+	if err = validators.ValidatePaginationParams(limit, offset); err != nil {
+		return 0, 0, err
+	}
+
+	return limit, offset, nil
+}
@@ -1,15 +1,92 @@
 package handlers
 
 import (
+	categoryHandlers "service/internal/handlers/category"
+	feedHandlers "service/internal/handlers/feed"
+	mediaHandlers "service/internal/handlers/media"
 	handler "service/internal/handlers/news"
+	sitemapHandlers "service/internal/handlers/sitemap"
+	"slices"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupRoutes(app *fiber.App, newsHandler handler.NewsHandler, middlewares ...fiber.Handler) {
+func SetupRoutes(app *fiber.App, newsHandler handler.NewsHandler, categoryHandler categoryHandlers.CategoryHandler,
+	mediaHandler mediaHandlers.MediaHandler, middlewares ...fiber.Handler) {
 	api := app.Group("/", middlewares...)
 
 	api.Post("edit/:id", newsHandler.EditNews)
 	api.Get("list", newsHandler.ListNews)
+	api.Get("search", newsHandler.SearchNews)
+	api.Get("export", newsHandler.ExportNews)
 	api.Post("create", newsHandler.CreateNews)
+	api.Post("news/bulk", newsHandler.CreateNewsBulk)
+	api.Post("news/bulk/edit", newsHandler.BulkEditNews)
+	api.Post("import", newsHandler.ImportNews)
+	api.Get("news/:id", newsHandler.GetNews)
+	api.Get("news/:id/related", newsHandler.RelatedNews)
+	api.Delete("news/:id", newsHandler.DeleteNews)
+	api.Post("news/:id/restore", newsHandler.RestoreNews)
+	api.Post("news/:id/categories", newsHandler.AddCategories)
+	api.Delete("news/:id/categories/:categoryId", newsHandler.RemoveCategory)
+	api.Post("news/:id/submit", newsHandler.SubmitForReview)
+	api.Post("news/:id/publish", newsHandler.Publish)
+	api.Post("news/:id/archive", newsHandler.Archive)
+	api.Post("news/:id/draft", newsHandler.ReturnToDraft)
+	api.Get("news/:id/revisions", newsHandler.ListRevisions)
+	api.Get("news/:id/revisions/diff", newsHandler.DiffRevisions)
+	api.Get("news/:id/revisions/:rev", newsHandler.GetRevision)
+	api.Post("news/:id/revisions/:rev/restore", newsHandler.RestoreRevision)
+	api.Get("news/:id/translations", newsHandler.ListTranslations)
+	api.Delete("news/:id/translations/:locale", newsHandler.DeleteTranslation)
+	api.Get("translations/completeness", newsHandler.TranslationCompleteness)
+	api.Get("trash", newsHandler.ListTrash)
+	api.Post("trash/purge", newsHandler.PurgeTrash)
+
+	api.Post("categories/create", categoryHandler.CreateCategory)
+	api.Post("categories/edit/:id", categoryHandler.EditCategory)
+	api.Get("categories/list", categoryHandler.ListCategories)
+	api.Get("categories/tree", categoryHandler.GetCategoryTree)
+	api.Get("categories/:id", categoryHandler.GetCategory)
+	api.Delete("categories/:id", categoryHandler.DeleteCategory)
+
+	api.Post("media", mediaHandler.UploadMedia)
+	api.Get("media/:id", mediaHandler.GetMedia)
+	api.Delete("media/:id", mediaHandler.DeleteMedia)
+}
+
+// SetupFeedRoutes registers the feeds with their own middlewares, so they can be read without the bearer token.
+// It must be called before SetupRoutes: the group middlewares registered there apply to every route after them.
+func SetupFeedRoutes(app *fiber.App, feedHandler feedHandlers.FeedHandler, middlewares ...fiber.Handler) {
+	route := func(handler fiber.Handler) []fiber.Handler {
+		return append(slices.Clone(middlewares), handler)
+	}
+
+	app.Get("feed.rss", route(feedHandler.RSS)...)
+	app.Get("feed.atom", route(feedHandler.Atom)...)
+	app.Get("categories/:id/feed.rss", route(feedHandler.RSS)...)
+	app.Get("categories/:id/feed.atom", route(feedHandler.Atom)...)
+}
+
+// SetupSitemapRoutes registers the sitemaps, which search engines read without the bearer token.
+// Like SetupFeedRoutes, it must be called before SetupRoutes.
+func SetupSitemapRoutes(app *fiber.App, sitemapHandler sitemapHandlers.SitemapHandler, middlewares ...fiber.Handler) {
+	route := func(handler fiber.Handler) []fiber.Handler {
+		return append(slices.Clone(middlewares), handler)
+	}
+
+	app.Get("sitemap.xml", route(sitemapHandler.Index)...)
+	app.Get("sitemap-:chunk.xml", route(sitemapHandler.Chunk)...)
+	app.Get("news-sitemap.xml", route(sitemapHandler.News)...)
+}
+
+// SetupMediaRoutes registers the media files, which pages embed and may serve without the bearer token.
+// Like SetupFeedRoutes, it must be called before SetupRoutes.
+func SetupMediaRoutes(app *fiber.App, mediaHandler mediaHandlers.MediaHandler, middlewares ...fiber.Handler) {
+	route := func(handler fiber.Handler) []fiber.Handler {
+		return append(slices.Clone(middlewares), handler)
+	}
+
+	app.Get("media/:id/file", route(mediaHandler.File)...)
+	app.Get("media/:id/thumbnails/:size", route(mediaHandler.Thumbnail)...)
 }
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"service/internal/apperrors"
+	"service/internal/sitemap"
+	"strconv"
+
+	"service/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const xmlContentType = "application/xml; charset=utf-8"
+
+type SitemapHandler struct {
+	sitemap *sitemap.Sitemap
+	log     *logger.Logger
+}
+
+func NewSitemapHandler(sitemap *sitemap.Sitemap, log *logger.Logger) SitemapHandler {
+	return SitemapHandler{
+		sitemap: sitemap,
+		log:     log,
+	}
+}
+
+type ErrorResponse struct {
+	Success bool   `json:"Success" example:"false"`
+	Error   string `json:"Error"`
+}
+
+// Index godoc
+// @Summary Sitemap index
+// @Description Sitemap index listing the sitemap files of published news, each with the latest change of its news as lastmod.
+// @Description News is split into files by ID ranges of 50000. Does not require the bearer token
+// @Tags sitemaps
+// @Produce xml
+// @Success 200 {string} string "Sitemap index"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Router /sitemap.xml [get]
+func (h *SitemapHandler) Index(c *fiber.Ctx) error {
+	body, err := h.sitemap.Index()
+	if err != nil {
+		return err
+	}
+
+	return send(c, body)
+}
+
+// Chunk godoc
+// @Summary Sitemap file
+// @Description Sitemap file of published news with IDs from (chunk-1)*50000+1 to chunk*50000, lastmod taken from UpdatedAt.
+// @Description Does not require the bearer token
+// @Tags sitemaps
+// @Produce xml
+// @Param chunk path int true "Number of the sitemap file, from 1"
+// @Success 200 {string} string "Sitemap"
+// @Failure 404 {object} ErrorResponse "Sitemap not found"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Router /sitemap-{chunk}.xml [get]
+func (h *SitemapHandler) Chunk(c *fiber.Ctx) error {
+	number, err := strconv.ParseInt(c.Params("chunk"), 10, 64)
+	if err != nil || number <= 0 {
+		return apperrors.NewNotFound("Sitemap not found")
+	}
+
+	body, err := h.sitemap.Chunk(number)
+	if err != nil {
+		return err
+	}
+
+	return send(c, body)
+}
+
+// News godoc
+// @Summary Google News sitemap
+// @Description Google News sitemap of at most 1000 news published in the last two days. Does not require the bearer token
+// @Tags sitemaps
+// @Produce xml
+// @Success 200 {string} string "News sitemap"
+// @Failure 500 {object} ErrorResponse "Internal Server Error"
+// @Router /news-sitemap.xml [get]
+func (h *SitemapHandler) News(c *fiber.Ctx) error {
+	body, err := h.sitemap.News()
+	if err != nil {
+		return err
+	}
+
+	return send(c, body)
+}
+
+func send(c *fiber.Ctx, body []byte) error {
+	c.Set(fiber.HeaderContentType, xmlContentType)
+	return c.Status(fiber.StatusOK).Send(body)
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"service/internal/configs"
+	"service/internal/handlers/errors"
+	"service/internal/models"
+	"service/internal/repository/mocks"
+	"service/internal/sitemap"
+	customLog "service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testLogger = func() *customLog.Logger {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	return &customLog.Logger{Logger: log}
+}()
+
+var testConfig = configs.Sitemap{
+	URLTemplate:     "https://example.com/news/{id}",
+	BaseURL:         "https://example.com",
+	PublicationName: "News",
+	Language:        "en",
+	RefreshInterval: 300,
+}
+
+type fakeClock struct{}
+
+func (fakeClock) Now() time.Time {
+	return time.Date(2025, 12, 21, 6, 0, 0, 0, time.UTC)
+}
+
+func setupApp(t *testing.T) (*fiber.App, *mocks.INewsRepository) {
+	mockRepo := new(mocks.INewsRepository)
+	t.Cleanup(func() {
+		mockRepo.AssertExpectations(t)
+	})
+
+	handler := NewSitemapHandler(sitemap.NewSitemap(mockRepo, fakeClock{}, testConfig, testLogger), testLogger)
+	app := fiber.New(fiber.Config{ErrorHandler: errors.ErrorHandler(testLogger)})
+	app.Get("/sitemap.xml", handler.Index)
+	app.Get("/sitemap-:chunk.xml", handler.Chunk)
+	app.Get("/news-sitemap.xml", handler.News)
+
+	return app, mockRepo
+}
+
+func TestSitemap(t *testing.T) {
+	chunks := []models.SitemapChunk{{Number: 1, LastMod: time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)}}
+
+	t.Run("Index", func(t *testing.T) {
+		app, mockRepo := setupApp(t)
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return(chunks, nil).Once()
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/sitemap.xml", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, xmlContentType, resp.Header.Get("Content-Type"))
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "<loc>https://example.com/sitemap-1.xml</loc>")
+	})
+
+	t.Run("Chunk", func(t *testing.T) {
+		app, mockRepo := setupApp(t)
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return(chunks, nil).Once()
+		mockRepo.On("GetSitemapEntries", int64(1), int64(models.SitemapChunkSize)).
+			Return([]models.SitemapEntry{{ID: 3, UpdatedAt: chunks[0].LastMod}}, nil).Once()
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/sitemap-1.xml", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "<loc>https://example.com/news/3</loc>")
+	})
+
+	t.Run("News", func(t *testing.T) {
+		app, mockRepo := setupApp(t)
+		mockRepo.On("GetRecentlyPublished", mock.Anything, int64(models.NewsSitemapSize)).
+			Return([]models.SitemapEntry{}, nil).Once()
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/news-sitemap.xml", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, xmlContentType, resp.Header.Get("Content-Type"))
+	})
+
+	notFound := []struct {
+		name  string
+		url   string
+		setup func(mockRepo *mocks.INewsRepository)
+	}{
+		{name: "InvalidNumber", url: "/sitemap-abc.xml"},
+		{name: "ZeroNumber", url: "/sitemap-0.xml"},
+		{
+			name: "MissingChunk",
+			url:  "/sitemap-2.xml",
+			setup: func(mockRepo *mocks.INewsRepository) {
+				mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return(chunks, nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range notFound {
+		t.Run(tc.name, func(t *testing.T) {
+			app, mockRepo := setupApp(t)
+			if tc.setup != nil {
+				tc.setup(mockRepo)
+			}
+
+			resp, err := app.Test(httptest.NewRequest("GET", tc.url, nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+
+			body, _ := io.ReadAll(resp.Body)
+			var response ErrorResponse
+			json.Unmarshal(body, &response)
+			assert.Equal(t, "Sitemap not found", response.Error)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// BulkItemResult is the outcome of one item of a bulk request, identified by its index in the request.
+type BulkItemResult struct {
+	Index int    `json:"Index" example:"0"`
+	Id    *int64 `json:"Id,omitempty" example:"12"`
+	Error string `json:"Error,omitempty"`
+}
+
+// ImportRowResult is the outcome of one record of an imported file, identified by the line it starts on.
+// Id is empty for accepted records of a dry run.
+type ImportRowResult struct {
+	Line  int    `json:"Line" example:"2"`
+	Id    *int64 `json:"Id,omitempty" example:"12"`
+	Error string `json:"Error,omitempty"`
+}
+
+const (
+	BulkAddCategories    = "add_categories"
+	BulkRemoveCategories = "remove_categories"
+	BulkReplaceCategory  = "replace_category"
+	BulkSetStatus        = "set_status"
+)
+
+var BulkOperations = []string{BulkAddCategories, BulkRemoveCategories, BulkReplaceCategory, BulkSetStatus}
+
+// NewsBulkEditForm applies one operation to the news listed in Ids, or to the news matching a list filter when Ids is empty.
+type NewsBulkEditForm struct {
+	Ids       []int64 `json:"Ids" validate:"omitempty,max=1000,dive,gt=0"`
+	Operation string  `json:"Operation" example:"add_categories"`
+	// Categories are added by add_categories and removed by remove_categories.
+	Categories []int64 `json:"Categories" validate:"omitempty,dive,gt=0"`
+	// From is replaced with To by replace_category.
+	From int64 `json:"From" validate:"omitempty,gt=0"`
+	To   int64 `json:"To" validate:"omitempty,gt=0"`
+	// Status is set by set_status, only on news allowed to move to it.
+	Status string `json:"Status" example:"published"`
+	Editor string `json:"-"`
+}
+
+func (f *NewsBulkEditForm) Validate() error {
+	if err := validate.Struct(f); err != nil {
+		return formatValidationError(err)
+	}
+
+	switch f.Operation {
+	case BulkAddCategories, BulkRemoveCategories:
+		if len(f.Categories) == 0 {
+			return errors.New("Categories: field is required")
+		}
+	case BulkReplaceCategory:
+		if f.From == 0 {
+			return errors.New("From: field is required")
+		}
+		if f.To == 0 {
+			return errors.New("To: field is required")
+		}
+		if f.From == f.To {
+			return errors.New("To: must differ from From")
+		}
+	case BulkSetStatus:
+		if !slices.Contains(NewsStatuses, f.Status) {
+			return fmt.Errorf("Status: must be one of: %s", strings.Join(NewsStatuses, ", "))
+		}
+	default:
+		return fmt.Errorf("Operation: must be one of: %s", strings.Join(BulkOperations, ", "))
+	}
+
+	return nil
+}
+
+// Normalize drops duplicate IDs.
+func (f *NewsBulkEditForm) Normalize() {
+	slices.Sort(f.Ids)
+	f.Ids = slices.Compact(f.Ids)
+	slices.Sort(f.Categories)
+	f.Categories = slices.Compact(f.Categories)
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+//go:generate reform
+//reform:categories
+type Category struct {
+	ID          int64  `json:"Id" reform:"id,pk"`
+	Name        string `json:"Name" reform:"name"`
+	Slug        string `json:"Slug" reform:"slug"`
+	Description string `json:"Description" reform:"description"`
+	ParentId    *int64 `json:"ParentId" reform:"parent_id"`
+}
+
+type CategoryNode struct {
+	Category
+	Children []CategoryNode `json:"Children"`
+}
+
+type CategoryCreateForm struct {
+	Name        string `json:"Name" validate:"required,min=1,max=255"`
+	Slug        string `json:"Slug" validate:"required,min=1,max=255,slug"`
+	Description string `json:"Description"`
+	ParentId    *int64 `json:"ParentId" validate:"omitempty,gt=0"`
+}
+
+type CategoryEditForm struct {
+	Name        *string `json:"Name" validate:"omitempty,min=1,max=255"`
+	Slug        *string `json:"Slug" validate:"omitempty,min=1,max=255,slug"`
+	Description *string `json:"Description"`
+	// ParentId moves the category under another one; 0 moves it to the root.
+	ParentId *int64 `json:"ParentId" validate:"omitempty,gte=0"`
+}
+
+var slugRegexp = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+func (f *CategoryCreateForm) Validate() error {
+	if err := validate.Struct(f); err != nil {
+		return formatValidationError(err)
+	}
+	return nil
+}
+
+func (f *CategoryCreateForm) Normalize() {
+	f.Name = strings.TrimSpace(f.Name)
+	f.Slug = strings.ToLower(strings.TrimSpace(f.Slug))
+	f.Description = strings.TrimSpace(f.Description)
+}
+
+func (f *CategoryEditForm) Validate() error {
+	if f.Name == nil && f.Slug == nil && f.Description == nil && f.ParentId == nil {
+		return errors.New("body must contain at least one field to update (Name, Slug, Description, or ParentId)")
+	}
+
+	if err := validate.Struct(f); err != nil {
+		return formatValidationError(err)
+	}
+	return nil
+}
+
+func (f *CategoryEditForm) Normalize() {
+	if f.Name != nil {
+		trimmed := strings.TrimSpace(*f.Name)
+		f.Name = &trimmed
+	}
+
+	if f.Slug != nil {
+		slug := strings.ToLower(strings.TrimSpace(*f.Slug))
+		f.Slug = &slug
+	}
+
+	if f.Description != nil {
+		trimmed := strings.TrimSpace(*f.Description)
+		f.Description = &trimmed
+	}
+}
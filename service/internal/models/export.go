@@ -0,0 +1,53 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ExportNDJSON = "ndjson"
+	ExportCSV    = "csv"
+)
+
+var ExportFormats = []string{ExportNDJSON, ExportCSV}
+
+// CSVCategorySeparator joins the category IDs of news into the single Categories column of CSV exports.
+const CSVCategorySeparator = ";"
+
+// NewsCSVColumns is the header row of CSV exports, in the order of CSVRecord values.
+var NewsCSVColumns = []string{
+	"Id", "Title", "Content", "ContentFormat", "Status", "PublishAt", "ExpiresAt",
+	"CreatedAt", "UpdatedAt", "PublishedAt", "Version", "Categories",
+}
+
+// CSVRecord renders news as a CSV row. Times are RFC 3339, empty for NULL.
+func (n NewsWithCategories) CSVRecord() []string {
+	categories := make([]string, len(n.Categories))
+	for i, id := range n.Categories {
+		categories[i] = strconv.FormatInt(id, 10)
+	}
+
+	return []string{
+		strconv.FormatInt(n.ID, 10),
+		n.Title,
+		n.Content,
+		n.ContentFormat,
+		n.Status,
+		formatCSVTime(n.PublishAt),
+		formatCSVTime(n.ExpiresAt),
+		formatCSVTime(&n.CreatedAt),
+		formatCSVTime(&n.UpdatedAt),
+		formatCSVTime(n.PublishedAt),
+		strconv.FormatInt(n.Version, 10),
+		strings.Join(categories, CSVCategorySeparator),
+	}
+}
+
+func formatCSVTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
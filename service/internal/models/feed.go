@@ -0,0 +1,10 @@
+package models
+
+// Feed is the latest published news for RSS and Atom feeds.
+type Feed struct {
+	// Category is set for the feed of a category, which also includes news of its subcategories.
+	Category *Category
+	News     []NewsWithCategories
+	// Categories holds the categories of the news by ID, for their names in the feed.
+	Categories map[int64]Category
+}
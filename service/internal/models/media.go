@@ -0,0 +1,70 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// MediaFileField is the multipart form field POST /media reads the uploaded file from.
+const MediaFileField = "file"
+
+// MediaMaxFileNameLength caps the stored name of uploaded files, longer names are cut.
+const MediaMaxFileNameLength = 255
+
+//go:generate reform
+//reform:media
+type Media struct {
+	ID int64 `json:"Id" reform:"id,pk"`
+	// FileName is the name the file was uploaded with, kept for display only.
+	FileName    string     `json:"FileName" reform:"file_name"`
+	ContentType string     `json:"ContentType" reform:"content_type"`
+	Size        int64      `json:"Size" reform:"size"`
+	Width       int        `json:"Width" reform:"width"`
+	Height      int        `json:"Height" reform:"height"`
+	Thumbnails  Thumbnails `json:"Thumbnails" reform:"thumbnails"`
+	CreatedAt   time.Time  `json:"CreatedAt" reform:"created_at"`
+}
+
+// Thumbnail is a scaled down copy of an image that fits a Size x Size square.
+type Thumbnail struct {
+	Size        int    `json:"Size"`
+	Width       int    `json:"Width"`
+	Height      int    `json:"Height"`
+	ContentType string `json:"ContentType"`
+}
+
+// Thumbnails are stored as a JSONB array in the media row.
+type Thumbnails []Thumbnail
+
+func (t Thumbnails) Value() (driver.Value, error) {
+	if t == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(t)
+}
+
+func (t *Thumbnails) Scan(src interface{}) error {
+	var data []byte
+	switch value := src.(type) {
+	case []byte:
+		data = value
+	case string:
+		data = []byte(value)
+	case nil:
+		*t = Thumbnails{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported thumbnails type %T", src)
+	}
+
+	return json.Unmarshal(data, t)
+}
+
+// MediaFile is an open file of media, the original or one of its thumbnails. The caller closes Body.
+type MediaFile struct {
+	ContentType string
+	Body        io.ReadCloser
+}
@@ -0,0 +1,166 @@
+// Code generated by gopkg.in/reform.v1. DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/reform.v1"
+	"gopkg.in/reform.v1/parse"
+)
+
+type mediaTableType struct {
+	s parse.StructInfo
+	z []interface{}
+}
+
+// Schema returns a schema name in SQL database ("").
+func (v *mediaTableType) Schema() string {
+	return v.s.SQLSchema
+}
+
+// Name returns a view or table name in SQL database ("media").
+func (v *mediaTableType) Name() string {
+	return v.s.SQLName
+}
+
+// Columns returns a new slice of column names for that view or table in SQL database.
+func (v *mediaTableType) Columns() []string {
+	return []string{
+		"id",
+		"file_name",
+		"content_type",
+		"size",
+		"width",
+		"height",
+		"thumbnails",
+		"created_at",
+	}
+}
+
+// NewStruct makes a new struct for that view or table.
+func (v *mediaTableType) NewStruct() reform.Struct {
+	return new(Media)
+}
+
+// NewRecord makes a new record for that table.
+func (v *mediaTableType) NewRecord() reform.Record {
+	return new(Media)
+}
+
+// PKColumnIndex returns an index of primary key column for that table in SQL database.
+func (v *mediaTableType) PKColumnIndex() uint {
+	return uint(v.s.PKFieldIndex)
+}
+
+// MediaTable represents media view or table in SQL database.
+var MediaTable = &mediaTableType{
+	s: parse.StructInfo{
+		Type:    "Media",
+		SQLName: "media",
+		Fields: []parse.FieldInfo{
+			{Name: "ID", Type: "int64", Column: "id"},
+			{Name: "FileName", Type: "string", Column: "file_name"},
+			{Name: "ContentType", Type: "string", Column: "content_type"},
+			{Name: "Size", Type: "int64", Column: "size"},
+			{Name: "Width", Type: "int", Column: "width"},
+			{Name: "Height", Type: "int", Column: "height"},
+			{Name: "Thumbnails", Type: "Thumbnails", Column: "thumbnails"},
+			{Name: "CreatedAt", Type: "time.Time", Column: "created_at"},
+		},
+		PKFieldIndex: 0,
+	},
+	z: new(Media).Values(),
+}
+
+// String returns a string representation of this struct or record.
+func (s Media) String() string {
+	res := make([]string, 8)
+	res[0] = "ID: " + reform.Inspect(s.ID, true)
+	res[1] = "FileName: " + reform.Inspect(s.FileName, true)
+	res[2] = "ContentType: " + reform.Inspect(s.ContentType, true)
+	res[3] = "Size: " + reform.Inspect(s.Size, true)
+	res[4] = "Width: " + reform.Inspect(s.Width, true)
+	res[5] = "Height: " + reform.Inspect(s.Height, true)
+	res[6] = "Thumbnails: " + reform.Inspect(s.Thumbnails, true)
+	res[7] = "CreatedAt: " + reform.Inspect(s.CreatedAt, true)
+	return strings.Join(res, ", ")
+}
+
+// Values returns a slice of struct or record field values.
+// Returned interface{} values are never untyped nils.
+func (s *Media) Values() []interface{} {
+	return []interface{}{
+		s.ID,
+		s.FileName,
+		s.ContentType,
+		s.Size,
+		s.Width,
+		s.Height,
+		s.Thumbnails,
+		s.CreatedAt,
+	}
+}
+
+// Pointers returns a slice of pointers to struct or record fields.
+// Returned interface{} values are never untyped nils.
+func (s *Media) Pointers() []interface{} {
+	return []interface{}{
+		&s.ID,
+		&s.FileName,
+		&s.ContentType,
+		&s.Size,
+		&s.Width,
+		&s.Height,
+		&s.Thumbnails,
+		&s.CreatedAt,
+	}
+}
+
+// View returns View object for that struct.
+func (s *Media) View() reform.View {
+	return MediaTable
+}
+
+// Table returns Table object for that record.
+func (s *Media) Table() reform.Table {
+	return MediaTable
+}
+
+// PKValue returns a value of primary key for that record.
+// Returned interface{} value is never untyped nil.
+func (s *Media) PKValue() interface{} {
+	return s.ID
+}
+
+// PKPointer returns a pointer to primary key field for that record.
+// Returned interface{} value is never untyped nil.
+func (s *Media) PKPointer() interface{} {
+	return &s.ID
+}
+
+// HasPK returns true if record has non-zero primary key set, false otherwise.
+func (s *Media) HasPK() bool {
+	return s.ID != MediaTable.z[MediaTable.s.PKFieldIndex]
+}
+
+// SetPK sets record primary key, if possible.
+//
+// Deprecated: prefer direct field assignment where possible: s.ID = pk.
+func (s *Media) SetPK(pk interface{}) {
+	reform.SetPK(s, pk)
+}
+
+// check interfaces
+var (
+	_ reform.View   = MediaTable
+	_ reform.Struct = (*Media)(nil)
+	_ reform.Table  = MediaTable
+	_ reform.Record = (*Media)(nil)
+	_ fmt.Stringer  = (*Media)(nil)
+)
+
+func init() {
+	parse.AssertUpToDate(&MediaTable.s, new(Media))
+}
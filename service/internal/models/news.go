@@ -3,59 +3,264 @@ package models
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
+
+	"service/pkg/markup"
 
 	"github.com/go-playground/validator/v10"
 )
 
+const (
+	NewsStatusDraft     = "draft"
+	NewsStatusReview    = "review"
+	NewsStatusPublished = "published"
+	NewsStatusArchived  = "archived"
+)
+
+var NewsStatuses = []string{NewsStatusDraft, NewsStatusReview, NewsStatusPublished, NewsStatusArchived}
+
+// Content formats tell how news content is turned into HTML. HTML content is sanitised before it is saved.
+const (
+	ContentFormatPlain    = "plain"
+	ContentFormatMarkdown = "markdown"
+	ContentFormatHTML     = "html"
+)
+
+var ContentFormats = []string{ContentFormatPlain, ContentFormatMarkdown, ContentFormatHTML}
+
 //go:generate reform
 //reform:news
 type News struct {
 	ID      int64  `json:"Id" reform:"id,pk"`
 	Title   string `json:"Title" reform:"title"`
 	Content string `json:"Content" reform:"content"`
+	// ContentFormat is one of ContentFormats.
+	ContentFormat string     `json:"ContentFormat" reform:"content_format"`
+	Status        string     `json:"Status" reform:"status"`
+	PublishAt     *time.Time `json:"PublishAt" reform:"publish_at"`
+	ExpiresAt     *time.Time `json:"ExpiresAt" reform:"expires_at"`
+	CreatedAt     time.Time  `json:"CreatedAt" reform:"created_at"`
+	UpdatedAt     time.Time  `json:"UpdatedAt" reform:"updated_at"`
+	PublishedAt   *time.Time `json:"PublishedAt" reform:"published_at"`
+	DeletedAt     *time.Time `json:"DeletedAt,omitempty" reform:"deleted_at"`
+	Version       int64      `json:"Version" reform:"version"`
 }
 
 type NewsWithCategories struct {
 	News
 	Categories []int64 `json:"Categories"`
+	// Media lists the IDs of attached media in the order they are shown.
+	Media []int64 `json:"Media"`
+	// ContentHTML is the content rendered as sanitised HTML, set only when a client asks for it.
+	ContentHTML *string `json:"ContentHTML,omitempty"`
+	// Locale is the locale of Title and Content, set only when a client asks for a locale.
+	Locale string `json:"Locale,omitempty" example:"en"`
+}
+
+// RenderHTML sets ContentHTML to the content rendered according to its format.
+func (n *NewsWithCategories) RenderHTML() error {
+	var rendered string
+	switch n.ContentFormat {
+	case ContentFormatMarkdown:
+		var err error
+		if rendered, err = markup.Markdown(n.Content); err != nil {
+			return err
+		}
+	case ContentFormatHTML:
+		rendered = markup.Sanitize(n.Content)
+	default:
+		rendered = markup.Plain(n.Content)
+	}
+
+	n.ContentHTML = &rendered
+	return nil
+}
+
+// NewsSearchResult is news matched by full-text search, with its rank and fragments where matches are wrapped in <b>.
+type NewsSearchResult struct {
+	NewsWithCategories
+	Rank             float64 `json:"Rank" example:"0.6"`
+	TitleHighlight   string  `json:"TitleHighlight"`
+	ContentHighlight string  `json:"ContentHighlight"`
+}
+
+// RelatedNews is news related to another one, with the score it is ranked by.
+type RelatedNews struct {
+	NewsWithCategories
+	Score float64 `json:"Score" example:"0.42"`
+}
+
+// RelatedWeights weigh the parts of the related news score: the overlap of categories, the similarity of
+// titles and the recency, which halves every HalfLifeDays.
+type RelatedWeights struct {
+	Category     float64
+	Title        float64
+	Recency      float64
+	HalfLifeDays float64
+}
+
+const (
+	NewsSortID          = "id"
+	NewsSortTitle       = "title"
+	NewsSortCreatedAt   = "created_at"
+	NewsSortUpdatedAt   = "updated_at"
+	NewsSortPublishedAt = "published_at"
+	// NewsSortRelevance orders by full-text search rank, so it is only available when searching.
+	NewsSortRelevance = "relevance"
+)
+
+var NewsSortFields = []string{NewsSortID, NewsSortTitle, NewsSortCreatedAt, NewsSortUpdatedAt, NewsSortPublishedAt}
+
+var SearchSortFields = append(slices.Clone(NewsSortFields), NewsSortRelevance)
+
+// SortKey is one key of a sort order; in query strings a leading "-" means descending, e.g. "-created_at".
+// Keys are comma-separated, later keys break ties of earlier ones: "-published_at,title".
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+type NewsSort []SortKey
+
+// String renders the sort in query string form, e.g. "-published_at,title".
+func (s NewsSort) String() string {
+	keys := make([]string, len(s))
+	for i, key := range s {
+		keys[i] = key.Field
+		if key.Desc {
+			keys[i] = "-" + key.Field
+		}
+	}
+
+	return strings.Join(keys, ",")
+}
+
+var DefaultNewsSort = NewsSort{{Field: NewsSortID, Desc: true}}
+
+var DefaultSearchSort = NewsSort{{Field: NewsSortRelevance, Desc: true}}
+
+const (
+	CategoryModeAny = "any"
+	CategoryModeAll = "all"
+)
+
+var CategoryModes = []string{CategoryModeAny, CategoryModeAll}
+
+// NewsFilter narrows news lists. Zero values mean "no restriction".
+type NewsFilter struct {
+	// CategoryIDs matches news tagged with any (CategoryModeAny) or all (CategoryModeAll) of the categories.
+	CategoryIDs        []int64
+	CategoryMode       string
+	ExcludeCategoryIDs []int64
+	// IncludeDescendants makes CategoryIDs and ExcludeCategoryIDs also match their subcategories.
+	IncludeDescendants bool
+	Statuses           []string
+	CreatedFrom        *time.Time
+	CreatedTo          *time.Time
+	TitleContains      string
+}
+
+// IsEmpty reports a filter that matches all news.
+func (f NewsFilter) IsEmpty() bool {
+	return len(f.CategoryIDs) == 0 && len(f.ExcludeCategoryIDs) == 0 && len(f.Statuses) == 0 &&
+		f.CreatedFrom == nil && f.CreatedTo == nil && f.TitleContains == ""
 }
 
 type NewsEditForm struct {
-	Title      *string  `json:"Title" validate:"omitempty,min=1,max=255"`
-	Content    *string  `json:"Content" validate:"omitempty,min=1"`
-	Categories *[]int64 `json:"Categories" validate:"omitempty,dive,gt=0"`
+	Title   *string `json:"Title" validate:"omitempty,min=1,max=255"`
+	Content *string `json:"Content" validate:"omitempty,min=1"`
+	// ContentFormat changes how the content is rendered; HTML content is sanitised.
+	ContentFormat *string  `json:"ContentFormat" validate:"omitempty,oneof=plain markdown html"`
+	Categories    *[]int64 `json:"Categories" validate:"omitempty,dive,gt=0"`
+	// Media replaces the attached media, in the order they are shown.
+	Media *[]int64 `json:"Media" validate:"omitempty,dive,gt=0"`
+	// PublishAt and ExpiresAt are left out of the JSON when nil, as null removes them. Like on create,
+	// PublishAt only publishes news in review.
+	PublishAt *time.Time `json:"PublishAt,omitempty"`
+	ExpiresAt *time.Time `json:"ExpiresAt,omitempty"`
+	// ClearPublishAt and ClearExpiresAt are set when PublishAt and ExpiresAt are sent as null, which removes them.
+	ClearPublishAt bool   `json:"-"`
+	ClearExpiresAt bool   `json:"-"`
+	Version        *int64 `json:"Version" validate:"omitempty,gt=0"`
+	Editor         string `json:"-"`
+	// Locale selects the translation to edit; empty or the default locale edits news itself.
+	Locale string `json:"-"`
 }
 
 type NewsCreateForm struct {
-	Title      string   `json:"Title" validate:"required,min=1,max=255"`
-	Content    string   `json:"Content" validate:"required,min=1"`
-	Categories *[]int64 `json:"Categories" validate:"omitempty,dive,gt=0"`
+	Title   string `json:"Title" validate:"required,min=1,max=255"`
+	Content string `json:"Content" validate:"required,min=1"`
+	// ContentFormat defaults to plain; HTML content is sanitised.
+	ContentFormat string   `json:"ContentFormat" validate:"omitempty,oneof=plain markdown html"`
+	Categories    *[]int64 `json:"Categories" validate:"omitempty,dive,gt=0"`
+	// Media lists IDs of uploaded media to attach, in the order they are shown.
+	Media *[]int64 `json:"Media" validate:"omitempty,dive,gt=0"`
+	// PublishAt is when the scheduler publishes the news. News is created as a draft, so it has to be
+	// submitted for review first: drafts are never published by the scheduler.
+	PublishAt *time.Time `json:"PublishAt"`
+	ExpiresAt *time.Time `json:"ExpiresAt"`
+	Editor    string     `json:"-"`
 }
 
-var validate = validator.New()
+// ScheduleResult reports what one run of the publication scheduler changed.
+type ScheduleResult struct {
+	Locked    bool
+	Published int64
+	Archived  int64
+}
+
+var validate = func() *validator.Validate {
+	v := validator.New()
+	_ = v.RegisterValidation("slug", func(fl validator.FieldLevel) bool {
+		return slugRegexp.MatchString(fl.Field().String())
+	})
+	return v
+}()
 
 func (n *NewsCreateForm) Validate() error {
 	if err := validate.Struct(n); err != nil {
 		return formatValidationError(err)
 	}
-	return nil
+	return validateSchedule(n.PublishAt, n.ExpiresAt)
 }
 
 func (n *NewsCreateForm) Normalize() {
 	n.Title = strings.TrimSpace(n.Title)
 	n.Content = strings.TrimSpace(n.Content)
+
+	n.ContentFormat = strings.ToLower(strings.TrimSpace(n.ContentFormat))
+
+	if n.Categories != nil {
+		categories := uniqueIDs(*n.Categories)
+		n.Categories = &categories
+	}
+
+	if n.Media != nil {
+		media := uniqueIDs(*n.Media)
+		n.Media = &media
+	}
 }
 
 func (n *NewsEditForm) Validate() error {
-	if n.Title == nil && n.Content == nil && n.Categories == nil {
-		return errors.New("body must contain at least one field to update (Title, Content, or Categories)")
+	if n.Title == nil && n.Content == nil && n.ContentFormat == nil && n.Categories == nil && n.Media == nil && !n.changesSchedule() {
+		return errors.New("body must contain at least one field to update (Title, Content, ContentFormat, Categories, Media, PublishAt, or ExpiresAt)")
 	}
 
 	if err := validate.Struct(n); err != nil {
 		return formatValidationError(err)
 	}
-	return nil
+	return validateSchedule(n.PublishAt, n.ExpiresAt)
+}
+
+// IsTranslatable reports a form that changes only Title and Content, the fields news has per locale.
+func (n *NewsEditForm) IsTranslatable() bool {
+	return n.ContentFormat == nil && n.Categories == nil && n.Media == nil && !n.changesSchedule()
+}
+
+func (n *NewsEditForm) changesSchedule() bool {
+	return n.PublishAt != nil || n.ExpiresAt != nil || n.ClearPublishAt || n.ClearExpiresAt
 }
 
 func (n *NewsEditForm) Normalize() {
@@ -68,6 +273,58 @@ func (n *NewsEditForm) Normalize() {
 		trimmed := strings.TrimSpace(*n.Content)
 		n.Content = &trimmed
 	}
+
+	if n.ContentFormat != nil {
+		format := strings.ToLower(strings.TrimSpace(*n.ContentFormat))
+		n.ContentFormat = &format
+	}
+
+	if n.Categories != nil {
+		categories := uniqueIDs(*n.Categories)
+		n.Categories = &categories
+	}
+
+	if n.Media != nil {
+		media := uniqueIDs(*n.Media)
+		n.Media = &media
+	}
+}
+
+// NewsCategoriesForm lists categories to attach to news, keeping the ones it already has.
+type NewsCategoriesForm struct {
+	Categories []int64 `json:"Categories" validate:"required,min=1,max=50,dive,gt=0"`
+}
+
+func (n *NewsCategoriesForm) Validate() error {
+	if err := validate.Struct(n); err != nil {
+		return formatValidationError(err)
+	}
+	return nil
+}
+
+func (n *NewsCategoriesForm) Normalize() {
+	if n.Categories != nil {
+		n.Categories = uniqueIDs(n.Categories)
+	}
+}
+
+// uniqueIDs drops repeated IDs, keeping the first occurrence of each.
+func uniqueIDs(ids []int64) []int64 {
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+
+	return unique
+}
+
+func validateSchedule(publishAt, expiresAt *time.Time) error {
+	if publishAt != nil && expiresAt != nil && !expiresAt.After(*publishAt) {
+		return errors.New("ExpiresAt: must be after PublishAt")
+	}
+	return nil
 }
 
 func formatValidationError(err error) error {
@@ -84,6 +341,12 @@ func formatValidationError(err error) error {
 				return fmt.Errorf("%s: maximum length is %s", e.Field(), e.Param())
 			case "gt":
 				return fmt.Errorf("%s: must be greater than %s", e.Field(), e.Param())
+			case "gte":
+				return fmt.Errorf("%s: must be greater or equal %s", e.Field(), e.Param())
+			case "slug":
+				return fmt.Errorf("%s: must contain only lowercase letters, digits and hyphens", e.Field())
+			case "oneof":
+				return fmt.Errorf("%s: must be one of: %s", e.Field(), strings.ReplaceAll(e.Param(), " ", ", "))
 			case "dive":
 				return fmt.Errorf("%s: contains invalid element", e.Field())
 			default:
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"strconv"
+	"time"
+)
+
+// NewsCursor marks a position in a sorted news list: the sort key values and ID of the news it points at.
+// Values holds one entry per key of Sort, nil for NULL.
+type NewsCursor struct {
+	Sort   string    `json:"s"`
+	Values []*string `json:"v"`
+	ID     int64     `json:"id"`
+	// Backward selects the page before the news instead of the page after it.
+	Backward bool `json:"b,omitempty"`
+}
+
+// NewsPage is one page of a news list with cursors of the neighbouring pages, nil when there is none.
+type NewsPage struct {
+	News       []NewsWithCategories
+	NextCursor *NewsCursor
+	PrevCursor *NewsCursor
+	// HasMore reports news after the page.
+	HasMore bool
+	// Total is the number of news matching the filter, nil when it was not counted.
+	Total *int64
+}
+
+const (
+	CountExact     = "exact"
+	CountEstimated = "estimated"
+	CountNone      = "none"
+)
+
+// CountModes are the ways to count Total: exact runs COUNT(*), estimated takes the query planner estimate.
+var CountModes = []string{CountExact, CountEstimated, CountNone}
+
+// NewsCursorAt returns the cursor pointing at news in a list sorted by sort.
+func NewsCursorAt(news News, sort NewsSort, backward bool) NewsCursor {
+	values := make([]*string, len(sort))
+	for i, key := range sort {
+		values[i] = news.sortValue(key.Field)
+	}
+
+	return NewsCursor{
+		Sort:     sort.String(),
+		Values:   values,
+		ID:       news.ID,
+		Backward: backward,
+	}
+}
+
+func (n News) sortValue(field string) *string {
+	var value string
+	switch field {
+	case NewsSortID:
+		value = strconv.FormatInt(n.ID, 10)
+	case NewsSortTitle:
+		value = n.Title
+	case NewsSortCreatedAt:
+		value = n.CreatedAt.Format(time.RFC3339Nano)
+	case NewsSortUpdatedAt:
+		value = n.UpdatedAt.Format(time.RFC3339Nano)
+	case NewsSortPublishedAt:
+		if n.PublishedAt == nil {
+			return nil
+		}
+		value = n.PublishedAt.Format(time.RFC3339Nano)
+	default:
+		return nil
+	}
+
+	return &value
+}
@@ -31,6 +31,15 @@ func (v *newsTableType) Columns() []string {
 		"id",
 		"title",
 		"content",
+		"content_format",
+		"status",
+		"publish_at",
+		"expires_at",
+		"created_at",
+		"updated_at",
+		"published_at",
+		"deleted_at",
+		"version",
 	}
 }
 
@@ -58,6 +67,15 @@ var NewsTable = &newsTableType{
 			{Name: "ID", Type: "int64", Column: "id"},
 			{Name: "Title", Type: "string", Column: "title"},
 			{Name: "Content", Type: "string", Column: "content"},
+			{Name: "ContentFormat", Type: "string", Column: "content_format"},
+			{Name: "Status", Type: "string", Column: "status"},
+			{Name: "PublishAt", Type: "*time.Time", Column: "publish_at"},
+			{Name: "ExpiresAt", Type: "*time.Time", Column: "expires_at"},
+			{Name: "CreatedAt", Type: "time.Time", Column: "created_at"},
+			{Name: "UpdatedAt", Type: "time.Time", Column: "updated_at"},
+			{Name: "PublishedAt", Type: "*time.Time", Column: "published_at"},
+			{Name: "DeletedAt", Type: "*time.Time", Column: "deleted_at"},
+			{Name: "Version", Type: "int64", Column: "version"},
 		},
 		PKFieldIndex: 0,
 	},
@@ -66,10 +84,19 @@ var NewsTable = &newsTableType{
 
 // String returns a string representation of this struct or record.
 func (s News) String() string {
-	res := make([]string, 3)
+	res := make([]string, 12)
 	res[0] = "ID: " + reform.Inspect(s.ID, true)
 	res[1] = "Title: " + reform.Inspect(s.Title, true)
 	res[2] = "Content: " + reform.Inspect(s.Content, true)
+	res[3] = "ContentFormat: " + reform.Inspect(s.ContentFormat, true)
+	res[4] = "Status: " + reform.Inspect(s.Status, true)
+	res[5] = "PublishAt: " + reform.Inspect(s.PublishAt, true)
+	res[6] = "ExpiresAt: " + reform.Inspect(s.ExpiresAt, true)
+	res[7] = "CreatedAt: " + reform.Inspect(s.CreatedAt, true)
+	res[8] = "UpdatedAt: " + reform.Inspect(s.UpdatedAt, true)
+	res[9] = "PublishedAt: " + reform.Inspect(s.PublishedAt, true)
+	res[10] = "DeletedAt: " + reform.Inspect(s.DeletedAt, true)
+	res[11] = "Version: " + reform.Inspect(s.Version, true)
 	return strings.Join(res, ", ")
 }
 
@@ -80,6 +107,15 @@ func (s *News) Values() []interface{} {
 		s.ID,
 		s.Title,
 		s.Content,
+		s.ContentFormat,
+		s.Status,
+		s.PublishAt,
+		s.ExpiresAt,
+		s.CreatedAt,
+		s.UpdatedAt,
+		s.PublishedAt,
+		s.DeletedAt,
+		s.Version,
 	}
 }
 
@@ -90,6 +126,15 @@ func (s *News) Pointers() []interface{} {
 		&s.ID,
 		&s.Title,
 		&s.Content,
+		&s.ContentFormat,
+		&s.Status,
+		&s.PublishAt,
+		&s.ExpiresAt,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+		&s.PublishedAt,
+		&s.DeletedAt,
+		&s.Version,
 	}
 }
 
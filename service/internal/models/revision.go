@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"service/pkg/diff"
+)
+
+// NewsRevision is a snapshot of news title, content and categories taken on every create and edit.
+type NewsRevision struct {
+	ID         int64     `json:"Id"`
+	NewsId     int64     `json:"NewsId"`
+	Revision   int64     `json:"Revision"`
+	Title      string    `json:"Title"`
+	Content    string    `json:"Content"`
+	Categories []int64   `json:"Categories"`
+	Editor     *string   `json:"Editor"`
+	CreatedAt  time.Time `json:"CreatedAt"`
+}
+
+type NewsRevisionDiff struct {
+	NewsId int64       `json:"NewsId"`
+	From   int64       `json:"From"`
+	To     int64       `json:"To"`
+	Lines  []diff.Line `json:"Lines"`
+}
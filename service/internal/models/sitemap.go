@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+const (
+	// SitemapChunkSize is the most URLs one sitemap file may hold under the sitemap protocol.
+	// News is split into files by ID ranges of this size, so a change to news touches only the file of its range.
+	SitemapChunkSize = 50000
+	// NewsSitemapSize and NewsSitemapMaxAge are the Google News sitemap limits: at most 1000 news published in the last two days.
+	NewsSitemapSize   = 1000
+	NewsSitemapMaxAge = 48 * time.Hour
+)
+
+// SitemapChunk is one sitemap file of published news, numbered from 1, with the latest change of its news.
+type SitemapChunk struct {
+	Number  int64
+	LastMod time.Time
+}
+
+// SitemapEntry is published news as listed in sitemaps.
+type SitemapEntry struct {
+	ID          int64
+	Title       string
+	UpdatedAt   time.Time
+	PublishedAt *time.Time
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// NewsTranslation is the title and content of news in a locale other than the default one,
+// which news itself is written in.
+type NewsTranslation struct {
+	NewsId    int64     `json:"NewsId"`
+	Locale    string    `json:"Locale" example:"en"`
+	Title     string    `json:"Title"`
+	Content   string    `json:"Content"`
+	UpdatedAt time.Time `json:"UpdatedAt"`
+}
+
+// NewsTranslations lists the translations of one news and the configured locales it is not translated to yet.
+type NewsTranslations struct {
+	NewsId        int64             `json:"NewsId"`
+	DefaultLocale string            `json:"DefaultLocale" example:"ru"`
+	Translations  []NewsTranslation `json:"Translations"`
+	Missing       []string          `json:"Missing"`
+}
+
+// TranslationCompleteness tells how much of the news matching a filter is translated to a locale.
+type TranslationCompleteness struct {
+	Locale     string  `json:"Locale" example:"en"`
+	Translated int64   `json:"Translated" example:"42"`
+	Total      int64   `json:"Total" example:"50"`
+	Percent    float64 `json:"Percent" example:"84"`
+}
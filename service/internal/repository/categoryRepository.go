@@ -0,0 +1,355 @@
+package repository
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"service/internal/apperrors"
+	"service/internal/models"
+
+	"service/pkg/logger"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/reform.v1"
+)
+
+var (
+	//go:embed sql/select_categories_by_limit_and_offset.sql
+	SqlSelectCategoriesByLimitAndOffset string
+	//go:embed sql/select_missing_category_ids.sql
+	SqlSelectMissingCategoryIDs string
+	//go:embed sql/select_all_categories.sql
+	SqlSelectAllCategories string
+	//go:embed sql/insert_category_closure.sql
+	SqlInsertCategoryClosure string
+	//go:embed sql/select_is_descendant_category.sql
+	SqlSelectIsDescendantCategory string
+	//go:embed sql/delete_category_closure_subtree_links.sql
+	SqlDeleteCategoryClosureSubtreeLinks string
+	//go:embed sql/insert_category_closure_subtree_links.sql
+	SqlInsertCategoryClosureSubtreeLinks string
+	//go:embed sql/lock_category_closure.sql
+	SqlLockCategoryClosure string
+)
+
+//go:generate mockery --name=ICategoryRepository --output=mocks --outpkg=mocks --case=snake --with-expecter
+type ICategoryRepository interface {
+	GetCategories(limit, offset int64) ([]models.Category, error)
+	GetAllCategories() ([]models.Category, error)
+	GetCategoryByID(categoryId int64) (models.Category, error)
+	CreateCategory(createForm models.CategoryCreateForm) (int64, error)
+	UpdateCategory(categoryId int64, editForm models.CategoryEditForm) error
+	DeleteCategory(categoryId int64) error
+	FindMissingIDs(categoryIDs []int64) ([]int64, error)
+}
+
+type CategoryRepository struct {
+	db  *reform.DB
+	log *logger.Logger
+	ctx context.Context
+}
+
+func NewCategoryRepository(db *reform.DB, log *logger.Logger, ctx context.Context) ICategoryRepository {
+	return &CategoryRepository{
+		db:  db,
+		log: log,
+		ctx: ctx,
+	}
+}
+
+func (r *CategoryRepository) GetCategories(limit, offset int64) ([]models.Category, error) {
+	return r.selectCategories("repository.category.GetCategories", SqlSelectCategoriesByLimitAndOffset, limit, offset)
+}
+
+func (r *CategoryRepository) GetAllCategories() ([]models.Category, error) {
+	return r.selectCategories("repository.category.GetAllCategories", SqlSelectAllCategories)
+}
+
+func (r *CategoryRepository) GetCategoryByID(categoryId int64) (models.Category, error) {
+	category, err := r.findCategoryByID(r.db.Querier, categoryId)
+	if err != nil {
+		return models.Category{}, err
+	}
+
+	return *category, nil
+}
+
+func (r *CategoryRepository) CreateCategory(createForm models.CategoryCreateForm) (int64, error) {
+	const op = "repository.category.CreateCategory"
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	if createForm.ParentId != nil {
+		if _, err = r.findCategoryByID(tx.Querier, *createForm.ParentId); err != nil {
+			if errors.Is(err, apperrors.ErrCategoryNotFound) {
+				return 0, apperrors.NewValidation("ParentId: parent category not found")
+			}
+			return 0, err
+		}
+	}
+
+	category := &models.Category{
+		Name:        createForm.Name,
+		Slug:        createForm.Slug,
+		Description: createForm.Description,
+		ParentId:    createForm.ParentId,
+	}
+
+	if err = tx.Insert(category); err != nil {
+		if isUniqueViolation(err) {
+			return 0, apperrors.NewConflict(fmt.Sprintf("Category with slug %q already exists", createForm.Slug))
+		}
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"slug":      createForm.Slug,
+		}).Error("Failed to insert category")
+		return 0, fmt.Errorf("failed to insert category: %w", err)
+	}
+
+	if _, err = tx.ExecContext(r.ctx, SqlInsertCategoryClosure, category.ID, category.ParentId); err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation":   op,
+			"category_id": category.ID,
+		}).Error("Failed to insert category closure")
+		return 0, fmt.Errorf("failed to insert category closure: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation":   op,
+		"category_id": category.ID,
+	}).Info("Category created successfully")
+
+	return category.ID, nil
+}
+
+func (r *CategoryRepository) UpdateCategory(categoryId int64, editForm models.CategoryEditForm) error {
+	const op = "repository.category.UpdateCategory"
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	category, err := r.findCategoryByID(tx.Querier, categoryId)
+	if err != nil {
+		return err
+	}
+
+	if editForm.Name != nil {
+		category.Name = *editForm.Name
+	}
+	if editForm.Slug != nil {
+		category.Slug = *editForm.Slug
+	}
+	if editForm.Description != nil {
+		category.Description = *editForm.Description
+	}
+	if editForm.ParentId != nil {
+		var parentId *int64
+		if *editForm.ParentId != 0 {
+			parentId = editForm.ParentId
+		}
+
+		if err = r.moveCategory(tx, categoryId, parentId); err != nil {
+			return err
+		}
+		category.ParentId = parentId
+	}
+
+	if err = tx.Update(category); err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.NewConflict(fmt.Sprintf("Category with slug %q already exists", category.Slug))
+		}
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation":   op,
+			"category_id": categoryId,
+		}).Error("Failed to update category")
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation":   op,
+		"category_id": categoryId,
+	}).Info("Category updated successfully")
+
+	return nil
+}
+
+func (r *CategoryRepository) DeleteCategory(categoryId int64) error {
+	const op = "repository.category.DeleteCategory"
+
+	err := r.db.Delete(&models.Category{ID: categoryId})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			// Categories are not detached from news here: news would change without a new version or revision.
+			if violatedConstraint(err) == "fk_category" {
+				return apperrors.NewConflict("Category is assigned to news, including news in the trash, remove it from them first")
+			}
+			return apperrors.NewConflict("Category has subcategories, move or delete them first")
+		}
+		if errors.Is(err, reform.ErrNoRows) {
+			r.log.WithFields(logrus.Fields{
+				"operation":   op,
+				"category_id": categoryId,
+			}).Warn("Category not found")
+			return apperrors.NewCategoryNotFound("Category not found")
+		}
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation":   op,
+			"category_id": categoryId,
+		}).Error("Failed to delete category")
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation":   op,
+		"category_id": categoryId,
+	}).Info("Category deleted successfully")
+
+	return nil
+}
+
+func (r *CategoryRepository) FindMissingIDs(categoryIDs []int64) ([]int64, error) {
+	const op = "repository.category.FindMissingIDs"
+
+	rows, err := r.db.QueryContext(r.ctx, SqlSelectMissingCategoryIDs, pq.Array(categoryIDs))
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to check categories")
+		return nil, fmt.Errorf("failed to check categories: %w", err)
+	}
+	defer rows.Close()
+
+	missing := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan category id")
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		missing = append(missing, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating category ids")
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return missing, nil
+}
+
+func (r *CategoryRepository) moveCategory(tx *reform.TX, categoryId int64, parentId *int64) error {
+	const op = "repository.category.moveCategory"
+
+	if _, err := tx.ExecContext(r.ctx, SqlLockCategoryClosure); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to lock category closure")
+		return fmt.Errorf("failed to lock category closure: %w", err)
+	}
+
+	if parentId != nil {
+		if _, err := r.findCategoryByID(tx.Querier, *parentId); err != nil {
+			if errors.Is(err, apperrors.ErrCategoryNotFound) {
+				return apperrors.NewValidation("ParentId: parent category not found")
+			}
+			return err
+		}
+
+		var isDescendant bool
+		if err := tx.QueryRowContext(r.ctx, SqlSelectIsDescendantCategory, categoryId, *parentId).Scan(&isDescendant); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to check category cycle")
+			return fmt.Errorf("failed to check category cycle: %w", err)
+		}
+
+		if isDescendant {
+			r.log.WithFields(logrus.Fields{
+				"operation":   op,
+				"category_id": categoryId,
+				"parent_id":   *parentId,
+			}).Warn("Category move would create a cycle")
+			return apperrors.NewValidation("ParentId: category cannot be moved under itself or its descendant")
+		}
+	}
+
+	if _, err := tx.ExecContext(r.ctx, SqlDeleteCategoryClosureSubtreeLinks, categoryId); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to detach category subtree")
+		return fmt.Errorf("failed to detach category subtree: %w", err)
+	}
+
+	if parentId != nil {
+		if _, err := tx.ExecContext(r.ctx, SqlInsertCategoryClosureSubtreeLinks, categoryId, *parentId); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to attach category subtree")
+			return fmt.Errorf("failed to attach category subtree: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *CategoryRepository) selectCategories(op, query string, args ...interface{}) ([]models.Category, error) {
+	rows, err := r.db.QueryContext(r.ctx, query, args...)
+	if err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"args":      args,
+		}).Error("Failed to select categories")
+		return nil, fmt.Errorf("failed to select categories: %w", err)
+	}
+	defer rows.Close()
+
+	categories := make([]models.Category, 0)
+	for rows.Next() {
+		var c models.Category
+		if err = rows.Scan(&c.ID, &c.Name, &c.Slug, &c.Description, &c.ParentId); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan category row")
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		categories = append(categories, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating category rows")
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) findCategoryByID(q *reform.Querier, categoryId int64) (*models.Category, error) {
+	const op = "repository.category.findCategoryByID"
+
+	record, err := q.FindByPrimaryKeyFrom(models.CategoryTable, categoryId)
+	if err != nil {
+		if errors.Is(err, reform.ErrNoRows) {
+			r.log.WithFields(logrus.Fields{
+				"operation":   op,
+				"category_id": categoryId,
+			}).Warn("Category not found")
+			return nil, apperrors.NewCategoryNotFound("Category not found")
+		}
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation":   op,
+			"category_id": categoryId,
+		}).Error("Failed to find category")
+		return nil, fmt.Errorf("failed to find category: %w", err)
+	}
+
+	return record.(*models.Category), nil
+}
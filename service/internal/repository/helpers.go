@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"service/pkg/logger"
+
+	"github.com/lib/pq"
+	"gopkg.in/reform.v1"
+)
+
+const (
+	pqForeignKeyViolation = "23503"
+	pqUniqueViolation     = "23505"
+)
+
+func rollbackOnError(log *logger.Logger, tx *reform.TX, op string) {
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		log.WithError(err).WithField("operation", op).Error("Failed to rollback transaction")
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pqForeignKeyViolation
+}
+
+// violatedConstraint returns the name of the constraint err violates, empty when there is none.
+func violatedConstraint(err error) string {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Constraint
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"service/internal/apperrors"
+	"service/internal/models"
+
+	"service/pkg/logger"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/reform.v1"
+)
+
+var (
+	//go:embed sql/insert_media.sql
+	SqlInsertMedia string
+)
+
+//go:generate mockery --name=IMediaRepository --output=mocks --outpkg=mocks --case=snake --with-expecter
+type IMediaRepository interface {
+	CreateMedia(media models.Media, save func(mediaId int64) error) (models.Media, error)
+	GetMediaByID(mediaId int64) (models.Media, error)
+	DeleteMedia(mediaId int64) error
+}
+
+type MediaRepository struct {
+	db  *reform.DB
+	log *logger.Logger
+	ctx context.Context
+}
+
+func NewMediaRepository(db *reform.DB, log *logger.Logger, ctx context.Context) IMediaRepository {
+	return &MediaRepository{
+		db:  db,
+		log: log,
+		ctx: ctx,
+	}
+}
+
+// CreateMedia inserts media and calls save with its ID before the transaction is committed,
+// so media whose files could not be saved is never visible.
+func (r *MediaRepository) CreateMedia(media models.Media, save func(mediaId int64) error) (models.Media, error) {
+	const op = "repository.media.CreateMedia"
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return models.Media{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	err = tx.QueryRowContext(r.ctx, SqlInsertMedia, media.FileName, media.ContentType, media.Size,
+		media.Width, media.Height, media.Thumbnails).Scan(&media.ID, &media.CreatedAt)
+	if err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"file_name": media.FileName,
+		}).Error("Failed to insert media")
+		return models.Media{}, fmt.Errorf("failed to insert media: %w", err)
+	}
+
+	if err = save(media.ID); err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"media_id":  media.ID,
+		}).Error("Failed to save media files")
+		return models.Media{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return models.Media{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"media_id":  media.ID,
+	}).Info("Media created successfully")
+
+	return media, nil
+}
+
+func (r *MediaRepository) GetMediaByID(mediaId int64) (models.Media, error) {
+	const op = "repository.media.GetMediaByID"
+
+	var media models.Media
+	if err := r.db.FindByPrimaryKeyTo(&media, mediaId); err != nil {
+		if errors.Is(err, reform.ErrNoRows) {
+			r.log.WithFields(logrus.Fields{
+				"operation": op,
+				"media_id":  mediaId,
+			}).Warn("Media not found")
+			return models.Media{}, apperrors.NewMediaNotFound("Media not found")
+		}
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"media_id":  mediaId,
+		}).Error("Failed to find media")
+		return models.Media{}, fmt.Errorf("failed to find media: %w", err)
+	}
+
+	return media, nil
+}
+
+// DeleteMedia deletes media that no news is using.
+func (r *MediaRepository) DeleteMedia(mediaId int64) error {
+	const op = "repository.media.DeleteMedia"
+
+	err := r.db.Delete(&models.Media{ID: mediaId})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return apperrors.NewConflict("Media is attached to news, detach it first")
+		}
+		if errors.Is(err, reform.ErrNoRows) {
+			r.log.WithFields(logrus.Fields{
+				"operation": op,
+				"media_id":  mediaId,
+			}).Warn("Media not found")
+			return apperrors.NewMediaNotFound("Media not found")
+		}
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"media_id":  mediaId,
+		}).Error("Failed to delete media")
+		return fmt.Errorf("failed to delete media: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"media_id":  mediaId,
+	}).Info("Media deleted successfully")
+
+	return nil
+}
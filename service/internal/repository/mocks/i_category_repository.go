@@ -0,0 +1,415 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICategoryRepository is an autogenerated mock type for the ICategoryRepository type
+type ICategoryRepository struct {
+	mock.Mock
+}
+
+type ICategoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ICategoryRepository) EXPECT() *ICategoryRepository_Expecter {
+	return &ICategoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateCategory provides a mock function with given fields: createForm
+func (_m *ICategoryRepository) CreateCategory(createForm models.CategoryCreateForm) (int64, error) {
+	ret := _m.Called(createForm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.CategoryCreateForm) (int64, error)); ok {
+		return rf(createForm)
+	}
+	if rf, ok := ret.Get(0).(func(models.CategoryCreateForm) int64); ok {
+		r0 = rf(createForm)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.CategoryCreateForm) error); ok {
+		r1 = rf(createForm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICategoryRepository_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type ICategoryRepository_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - createForm models.CategoryCreateForm
+func (_e *ICategoryRepository_Expecter) CreateCategory(createForm interface{}) *ICategoryRepository_CreateCategory_Call {
+	return &ICategoryRepository_CreateCategory_Call{Call: _e.mock.On("CreateCategory", createForm)}
+}
+
+func (_c *ICategoryRepository_CreateCategory_Call) Run(run func(createForm models.CategoryCreateForm)) *ICategoryRepository_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.CategoryCreateForm))
+	})
+	return _c
+}
+
+func (_c *ICategoryRepository_CreateCategory_Call) Return(_a0 int64, _a1 error) *ICategoryRepository_CreateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICategoryRepository_CreateCategory_Call) RunAndReturn(run func(models.CategoryCreateForm) (int64, error)) *ICategoryRepository_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: categoryId
+func (_m *ICategoryRepository) DeleteCategory(categoryId int64) error {
+	ret := _m.Called(categoryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(categoryId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ICategoryRepository_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type ICategoryRepository_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - categoryId int64
+func (_e *ICategoryRepository_Expecter) DeleteCategory(categoryId interface{}) *ICategoryRepository_DeleteCategory_Call {
+	return &ICategoryRepository_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", categoryId)}
+}
+
+func (_c *ICategoryRepository_DeleteCategory_Call) Run(run func(categoryId int64)) *ICategoryRepository_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *ICategoryRepository_DeleteCategory_Call) Return(_a0 error) *ICategoryRepository_DeleteCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ICategoryRepository_DeleteCategory_Call) RunAndReturn(run func(int64) error) *ICategoryRepository_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMissingIDs provides a mock function with given fields: categoryIDs
+func (_m *ICategoryRepository) FindMissingIDs(categoryIDs []int64) ([]int64, error) {
+	ret := _m.Called(categoryIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMissingIDs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int64) ([]int64, error)); ok {
+		return rf(categoryIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]int64) []int64); ok {
+		r0 = rf(categoryIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int64) error); ok {
+		r1 = rf(categoryIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICategoryRepository_FindMissingIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMissingIDs'
+type ICategoryRepository_FindMissingIDs_Call struct {
+	*mock.Call
+}
+
+// FindMissingIDs is a helper method to define mock.On call
+//   - categoryIDs []int64
+func (_e *ICategoryRepository_Expecter) FindMissingIDs(categoryIDs interface{}) *ICategoryRepository_FindMissingIDs_Call {
+	return &ICategoryRepository_FindMissingIDs_Call{Call: _e.mock.On("FindMissingIDs", categoryIDs)}
+}
+
+func (_c *ICategoryRepository_FindMissingIDs_Call) Run(run func(categoryIDs []int64)) *ICategoryRepository_FindMissingIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]int64))
+	})
+	return _c
+}
+
+func (_c *ICategoryRepository_FindMissingIDs_Call) Return(_a0 []int64, _a1 error) *ICategoryRepository_FindMissingIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICategoryRepository_FindMissingIDs_Call) RunAndReturn(run func([]int64) ([]int64, error)) *ICategoryRepository_FindMissingIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllCategories provides a mock function with no fields
+func (_m *ICategoryRepository) GetAllCategories() ([]models.Category, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCategories")
+	}
+
+	var r0 []models.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Category, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Category); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICategoryRepository_GetAllCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllCategories'
+type ICategoryRepository_GetAllCategories_Call struct {
+	*mock.Call
+}
+
+// GetAllCategories is a helper method to define mock.On call
+func (_e *ICategoryRepository_Expecter) GetAllCategories() *ICategoryRepository_GetAllCategories_Call {
+	return &ICategoryRepository_GetAllCategories_Call{Call: _e.mock.On("GetAllCategories")}
+}
+
+func (_c *ICategoryRepository_GetAllCategories_Call) Run(run func()) *ICategoryRepository_GetAllCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ICategoryRepository_GetAllCategories_Call) Return(_a0 []models.Category, _a1 error) *ICategoryRepository_GetAllCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICategoryRepository_GetAllCategories_Call) RunAndReturn(run func() ([]models.Category, error)) *ICategoryRepository_GetAllCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategories provides a mock function with given fields: limit, offset
+func (_m *ICategoryRepository) GetCategories(limit int64, offset int64) ([]models.Category, error) {
+	ret := _m.Called(limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategories")
+	}
+
+	var r0 []models.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) ([]models.Category, error)); ok {
+		return rf(limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) []models.Category); ok {
+		r0 = rf(limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICategoryRepository_GetCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategories'
+type ICategoryRepository_GetCategories_Call struct {
+	*mock.Call
+}
+
+// GetCategories is a helper method to define mock.On call
+//   - limit int64
+//   - offset int64
+func (_e *ICategoryRepository_Expecter) GetCategories(limit interface{}, offset interface{}) *ICategoryRepository_GetCategories_Call {
+	return &ICategoryRepository_GetCategories_Call{Call: _e.mock.On("GetCategories", limit, offset)}
+}
+
+func (_c *ICategoryRepository_GetCategories_Call) Run(run func(limit int64, offset int64)) *ICategoryRepository_GetCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ICategoryRepository_GetCategories_Call) Return(_a0 []models.Category, _a1 error) *ICategoryRepository_GetCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICategoryRepository_GetCategories_Call) RunAndReturn(run func(int64, int64) ([]models.Category, error)) *ICategoryRepository_GetCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryByID provides a mock function with given fields: categoryId
+func (_m *ICategoryRepository) GetCategoryByID(categoryId int64) (models.Category, error) {
+	ret := _m.Called(categoryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryByID")
+	}
+
+	var r0 models.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (models.Category, error)); ok {
+		return rf(categoryId)
+	}
+	if rf, ok := ret.Get(0).(func(int64) models.Category); ok {
+		r0 = rf(categoryId)
+	} else {
+		r0 = ret.Get(0).(models.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(categoryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICategoryRepository_GetCategoryByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryByID'
+type ICategoryRepository_GetCategoryByID_Call struct {
+	*mock.Call
+}
+
+// GetCategoryByID is a helper method to define mock.On call
+//   - categoryId int64
+func (_e *ICategoryRepository_Expecter) GetCategoryByID(categoryId interface{}) *ICategoryRepository_GetCategoryByID_Call {
+	return &ICategoryRepository_GetCategoryByID_Call{Call: _e.mock.On("GetCategoryByID", categoryId)}
+}
+
+func (_c *ICategoryRepository_GetCategoryByID_Call) Run(run func(categoryId int64)) *ICategoryRepository_GetCategoryByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *ICategoryRepository_GetCategoryByID_Call) Return(_a0 models.Category, _a1 error) *ICategoryRepository_GetCategoryByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICategoryRepository_GetCategoryByID_Call) RunAndReturn(run func(int64) (models.Category, error)) *ICategoryRepository_GetCategoryByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function with given fields: categoryId, editForm
+func (_m *ICategoryRepository) UpdateCategory(categoryId int64, editForm models.CategoryEditForm) error {
+	ret := _m.Called(categoryId, editForm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, models.CategoryEditForm) error); ok {
+		r0 = rf(categoryId, editForm)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ICategoryRepository_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type ICategoryRepository_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - categoryId int64
+//   - editForm models.CategoryEditForm
+func (_e *ICategoryRepository_Expecter) UpdateCategory(categoryId interface{}, editForm interface{}) *ICategoryRepository_UpdateCategory_Call {
+	return &ICategoryRepository_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", categoryId, editForm)}
+}
+
+func (_c *ICategoryRepository_UpdateCategory_Call) Run(run func(categoryId int64, editForm models.CategoryEditForm)) *ICategoryRepository_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(models.CategoryEditForm))
+	})
+	return _c
+}
+
+func (_c *ICategoryRepository_UpdateCategory_Call) Return(_a0 error) *ICategoryRepository_UpdateCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ICategoryRepository_UpdateCategory_Call) RunAndReturn(run func(int64, models.CategoryEditForm) error) *ICategoryRepository_UpdateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewICategoryRepository creates a new instance of ICategoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICategoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICategoryRepository {
+	mock := &ICategoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,195 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IMediaRepository is an autogenerated mock type for the IMediaRepository type
+type IMediaRepository struct {
+	mock.Mock
+}
+
+type IMediaRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IMediaRepository) EXPECT() *IMediaRepository_Expecter {
+	return &IMediaRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateMedia provides a mock function with given fields: media, save
+func (_m *IMediaRepository) CreateMedia(media models.Media, save func(int64) error) (models.Media, error) {
+	ret := _m.Called(media, save)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMedia")
+	}
+
+	var r0 models.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Media, func(int64) error) (models.Media, error)); ok {
+		return rf(media, save)
+	}
+	if rf, ok := ret.Get(0).(func(models.Media, func(int64) error) models.Media); ok {
+		r0 = rf(media, save)
+	} else {
+		r0 = ret.Get(0).(models.Media)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Media, func(int64) error) error); ok {
+		r1 = rf(media, save)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMediaRepository_CreateMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMedia'
+type IMediaRepository_CreateMedia_Call struct {
+	*mock.Call
+}
+
+// CreateMedia is a helper method to define mock.On call
+//   - media models.Media
+//   - save func(int64) error
+func (_e *IMediaRepository_Expecter) CreateMedia(media interface{}, save interface{}) *IMediaRepository_CreateMedia_Call {
+	return &IMediaRepository_CreateMedia_Call{Call: _e.mock.On("CreateMedia", media, save)}
+}
+
+func (_c *IMediaRepository_CreateMedia_Call) Run(run func(media models.Media, save func(int64) error)) *IMediaRepository_CreateMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Media), args[1].(func(int64) error))
+	})
+	return _c
+}
+
+func (_c *IMediaRepository_CreateMedia_Call) Return(_a0 models.Media, _a1 error) *IMediaRepository_CreateMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMediaRepository_CreateMedia_Call) RunAndReturn(run func(models.Media, func(int64) error) (models.Media, error)) *IMediaRepository_CreateMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMedia provides a mock function with given fields: mediaId
+func (_m *IMediaRepository) DeleteMedia(mediaId int64) error {
+	ret := _m.Called(mediaId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(mediaId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMediaRepository_DeleteMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMedia'
+type IMediaRepository_DeleteMedia_Call struct {
+	*mock.Call
+}
+
+// DeleteMedia is a helper method to define mock.On call
+//   - mediaId int64
+func (_e *IMediaRepository_Expecter) DeleteMedia(mediaId interface{}) *IMediaRepository_DeleteMedia_Call {
+	return &IMediaRepository_DeleteMedia_Call{Call: _e.mock.On("DeleteMedia", mediaId)}
+}
+
+func (_c *IMediaRepository_DeleteMedia_Call) Run(run func(mediaId int64)) *IMediaRepository_DeleteMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *IMediaRepository_DeleteMedia_Call) Return(_a0 error) *IMediaRepository_DeleteMedia_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMediaRepository_DeleteMedia_Call) RunAndReturn(run func(int64) error) *IMediaRepository_DeleteMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMediaByID provides a mock function with given fields: mediaId
+func (_m *IMediaRepository) GetMediaByID(mediaId int64) (models.Media, error) {
+	ret := _m.Called(mediaId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMediaByID")
+	}
+
+	var r0 models.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (models.Media, error)); ok {
+		return rf(mediaId)
+	}
+	if rf, ok := ret.Get(0).(func(int64) models.Media); ok {
+		r0 = rf(mediaId)
+	} else {
+		r0 = ret.Get(0).(models.Media)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(mediaId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMediaRepository_GetMediaByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaByID'
+type IMediaRepository_GetMediaByID_Call struct {
+	*mock.Call
+}
+
+// GetMediaByID is a helper method to define mock.On call
+//   - mediaId int64
+func (_e *IMediaRepository_Expecter) GetMediaByID(mediaId interface{}) *IMediaRepository_GetMediaByID_Call {
+	return &IMediaRepository_GetMediaByID_Call{Call: _e.mock.On("GetMediaByID", mediaId)}
+}
+
+func (_c *IMediaRepository_GetMediaByID_Call) Run(run func(mediaId int64)) *IMediaRepository_GetMediaByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *IMediaRepository_GetMediaByID_Call) Return(_a0 models.Media, _a1 error) *IMediaRepository_GetMediaByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMediaRepository_GetMediaByID_Call) RunAndReturn(run func(int64) (models.Media, error)) *IMediaRepository_GetMediaByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIMediaRepository creates a new instance of IMediaRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIMediaRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IMediaRepository {
+	mock := &IMediaRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,9 +3,12 @@
 package mocks
 
 import (
+	context "context"
 	models "service/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // INewsRepository is an autogenerated mock type for the INewsRepository type
@@ -21,6 +24,237 @@ func (_m *INewsRepository) EXPECT() *INewsRepository_Expecter {
 	return &INewsRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddNewsCategories provides a mock function with given fields: newsId, categoryIDs, editor
+func (_m *INewsRepository) AddNewsCategories(newsId int64, categoryIDs []int64, editor string) (models.NewsWithCategories, error) {
+	ret := _m.Called(newsId, categoryIDs, editor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddNewsCategories")
+	}
+
+	var r0 models.NewsWithCategories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, []int64, string) (models.NewsWithCategories, error)); ok {
+		return rf(newsId, categoryIDs, editor)
+	}
+	if rf, ok := ret.Get(0).(func(int64, []int64, string) models.NewsWithCategories); ok {
+		r0 = rf(newsId, categoryIDs, editor)
+	} else {
+		r0 = ret.Get(0).(models.NewsWithCategories)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, []int64, string) error); ok {
+		r1 = rf(newsId, categoryIDs, editor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_AddNewsCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddNewsCategories'
+type INewsRepository_AddNewsCategories_Call struct {
+	*mock.Call
+}
+
+// AddNewsCategories is a helper method to define mock.On call
+//   - newsId int64
+//   - categoryIDs []int64
+//   - editor string
+func (_e *INewsRepository_Expecter) AddNewsCategories(newsId interface{}, categoryIDs interface{}, editor interface{}) *INewsRepository_AddNewsCategories_Call {
+	return &INewsRepository_AddNewsCategories_Call{Call: _e.mock.On("AddNewsCategories", newsId, categoryIDs, editor)}
+}
+
+func (_c *INewsRepository_AddNewsCategories_Call) Run(run func(newsId int64, categoryIDs []int64, editor string)) *INewsRepository_AddNewsCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].([]int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_AddNewsCategories_Call) Return(_a0 models.NewsWithCategories, _a1 error) *INewsRepository_AddNewsCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_AddNewsCategories_Call) RunAndReturn(run func(int64, []int64, string) (models.NewsWithCategories, error)) *INewsRepository_AddNewsCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkEditNews provides a mock function with given fields: editForm, filter, allowedFrom
+func (_m *INewsRepository) BulkEditNews(editForm models.NewsBulkEditForm, filter models.NewsFilter, allowedFrom []string) (int64, error) {
+	ret := _m.Called(editForm, filter, allowedFrom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkEditNews")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.NewsBulkEditForm, models.NewsFilter, []string) (int64, error)); ok {
+		return rf(editForm, filter, allowedFrom)
+	}
+	if rf, ok := ret.Get(0).(func(models.NewsBulkEditForm, models.NewsFilter, []string) int64); ok {
+		r0 = rf(editForm, filter, allowedFrom)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.NewsBulkEditForm, models.NewsFilter, []string) error); ok {
+		r1 = rf(editForm, filter, allowedFrom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_BulkEditNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkEditNews'
+type INewsRepository_BulkEditNews_Call struct {
+	*mock.Call
+}
+
+// BulkEditNews is a helper method to define mock.On call
+//   - editForm models.NewsBulkEditForm
+//   - filter models.NewsFilter
+//   - allowedFrom []string
+func (_e *INewsRepository_Expecter) BulkEditNews(editForm interface{}, filter interface{}, allowedFrom interface{}) *INewsRepository_BulkEditNews_Call {
+	return &INewsRepository_BulkEditNews_Call{Call: _e.mock.On("BulkEditNews", editForm, filter, allowedFrom)}
+}
+
+func (_c *INewsRepository_BulkEditNews_Call) Run(run func(editForm models.NewsBulkEditForm, filter models.NewsFilter, allowedFrom []string)) *INewsRepository_BulkEditNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.NewsBulkEditForm), args[1].(models.NewsFilter), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_BulkEditNews_Call) Return(_a0 int64, _a1 error) *INewsRepository_BulkEditNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_BulkEditNews_Call) RunAndReturn(run func(models.NewsBulkEditForm, models.NewsFilter, []string) (int64, error)) *INewsRepository_BulkEditNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountNews provides a mock function with given fields: filter
+func (_m *INewsRepository) CountNews(filter models.NewsFilter) (int64, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountNews")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.NewsFilter) (int64, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.NewsFilter) int64); ok {
+		r0 = rf(filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.NewsFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_CountNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountNews'
+type INewsRepository_CountNews_Call struct {
+	*mock.Call
+}
+
+// CountNews is a helper method to define mock.On call
+//   - filter models.NewsFilter
+func (_e *INewsRepository_Expecter) CountNews(filter interface{}) *INewsRepository_CountNews_Call {
+	return &INewsRepository_CountNews_Call{Call: _e.mock.On("CountNews", filter)}
+}
+
+func (_c *INewsRepository_CountNews_Call) Run(run func(filter models.NewsFilter)) *INewsRepository_CountNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.NewsFilter))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_CountNews_Call) Return(_a0 int64, _a1 error) *INewsRepository_CountNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_CountNews_Call) RunAndReturn(run func(models.NewsFilter) (int64, error)) *INewsRepository_CountNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountTranslations provides a mock function with given fields: filter, locales
+func (_m *INewsRepository) CountTranslations(filter models.NewsFilter, locales []string) (map[string]int64, error) {
+	ret := _m.Called(filter, locales)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountTranslations")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.NewsFilter, []string) (map[string]int64, error)); ok {
+		return rf(filter, locales)
+	}
+	if rf, ok := ret.Get(0).(func(models.NewsFilter, []string) map[string]int64); ok {
+		r0 = rf(filter, locales)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.NewsFilter, []string) error); ok {
+		r1 = rf(filter, locales)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_CountTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountTranslations'
+type INewsRepository_CountTranslations_Call struct {
+	*mock.Call
+}
+
+// CountTranslations is a helper method to define mock.On call
+//   - filter models.NewsFilter
+//   - locales []string
+func (_e *INewsRepository_Expecter) CountTranslations(filter interface{}, locales interface{}) *INewsRepository_CountTranslations_Call {
+	return &INewsRepository_CountTranslations_Call{Call: _e.mock.On("CountTranslations", filter, locales)}
+}
+
+func (_c *INewsRepository_CountTranslations_Call) Run(run func(filter models.NewsFilter, locales []string)) *INewsRepository_CountTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.NewsFilter), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_CountTranslations_Call) Return(_a0 map[string]int64, _a1 error) *INewsRepository_CountTranslations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_CountTranslations_Call) RunAndReturn(run func(models.NewsFilter, []string) (map[string]int64, error)) *INewsRepository_CountTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateNews provides a mock function with given fields: createForm
 func (_m *INewsRepository) CreateNews(createForm models.NewsCreateForm) (int64, error) {
 	ret := _m.Called(createForm)
@@ -77,29 +311,29 @@ func (_c *INewsRepository_CreateNews_Call) RunAndReturn(run func(models.NewsCrea
 	return _c
 }
 
-// GetNews provides a mock function with given fields: limit, offset
-func (_m *INewsRepository) GetNews(limit int64, offset int64) ([]models.NewsWithCategories, error) {
-	ret := _m.Called(limit, offset)
+// CreateNewsBulk provides a mock function with given fields: createForms, editor
+func (_m *INewsRepository) CreateNewsBulk(createForms []models.NewsCreateForm, editor string) ([]int64, error) {
+	ret := _m.Called(createForms, editor)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNews")
+		panic("no return value specified for CreateNewsBulk")
 	}
 
-	var r0 []models.NewsWithCategories
+	var r0 []int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int64, int64) ([]models.NewsWithCategories, error)); ok {
-		return rf(limit, offset)
+	if rf, ok := ret.Get(0).(func([]models.NewsCreateForm, string) ([]int64, error)); ok {
+		return rf(createForms, editor)
 	}
-	if rf, ok := ret.Get(0).(func(int64, int64) []models.NewsWithCategories); ok {
-		r0 = rf(limit, offset)
+	if rf, ok := ret.Get(0).(func([]models.NewsCreateForm, string) []int64); ok {
+		r0 = rf(createForms, editor)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.NewsWithCategories)
+			r0 = ret.Get(0).([]int64)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
-		r1 = rf(limit, offset)
+	if rf, ok := ret.Get(1).(func([]models.NewsCreateForm, string) error); ok {
+		r1 = rf(createForms, editor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -107,46 +341,46 @@ func (_m *INewsRepository) GetNews(limit int64, offset int64) ([]models.NewsWith
 	return r0, r1
 }
 
-// INewsRepository_GetNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNews'
-type INewsRepository_GetNews_Call struct {
+// INewsRepository_CreateNewsBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNewsBulk'
+type INewsRepository_CreateNewsBulk_Call struct {
 	*mock.Call
 }
 
-// GetNews is a helper method to define mock.On call
-//   - limit int64
-//   - offset int64
-func (_e *INewsRepository_Expecter) GetNews(limit interface{}, offset interface{}) *INewsRepository_GetNews_Call {
-	return &INewsRepository_GetNews_Call{Call: _e.mock.On("GetNews", limit, offset)}
+// CreateNewsBulk is a helper method to define mock.On call
+//   - createForms []models.NewsCreateForm
+//   - editor string
+func (_e *INewsRepository_Expecter) CreateNewsBulk(createForms interface{}, editor interface{}) *INewsRepository_CreateNewsBulk_Call {
+	return &INewsRepository_CreateNewsBulk_Call{Call: _e.mock.On("CreateNewsBulk", createForms, editor)}
 }
 
-func (_c *INewsRepository_GetNews_Call) Run(run func(limit int64, offset int64)) *INewsRepository_GetNews_Call {
+func (_c *INewsRepository_CreateNewsBulk_Call) Run(run func(createForms []models.NewsCreateForm, editor string)) *INewsRepository_CreateNewsBulk_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int64), args[1].(int64))
+		run(args[0].([]models.NewsCreateForm), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *INewsRepository_GetNews_Call) Return(_a0 []models.NewsWithCategories, _a1 error) *INewsRepository_GetNews_Call {
+func (_c *INewsRepository_CreateNewsBulk_Call) Return(_a0 []int64, _a1 error) *INewsRepository_CreateNewsBulk_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *INewsRepository_GetNews_Call) RunAndReturn(run func(int64, int64) ([]models.NewsWithCategories, error)) *INewsRepository_GetNews_Call {
+func (_c *INewsRepository_CreateNewsBulk_Call) RunAndReturn(run func([]models.NewsCreateForm, string) ([]int64, error)) *INewsRepository_CreateNewsBulk_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateNews provides a mock function with given fields: newsId, updateFields, categories
-func (_m *INewsRepository) UpdateNews(newsId int64, updateFields map[string]interface{}, categories *[]int64) error {
-	ret := _m.Called(newsId, updateFields, categories)
+// DeleteNews provides a mock function with given fields: newsId
+func (_m *INewsRepository) DeleteNews(newsId int64) error {
+	ret := _m.Called(newsId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateNews")
+		panic("no return value specified for DeleteNews")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int64, map[string]interface{}, *[]int64) error); ok {
-		r0 = rf(newsId, updateFields, categories)
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(newsId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -154,32 +388,1195 @@ func (_m *INewsRepository) UpdateNews(newsId int64, updateFields map[string]inte
 	return r0
 }
 
-// INewsRepository_UpdateNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNews'
-type INewsRepository_UpdateNews_Call struct {
+// INewsRepository_DeleteNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNews'
+type INewsRepository_DeleteNews_Call struct {
 	*mock.Call
 }
 
-// UpdateNews is a helper method to define mock.On call
+// DeleteNews is a helper method to define mock.On call
 //   - newsId int64
-//   - updateFields map[string]interface{}
-//   - categories *[]int64
-func (_e *INewsRepository_Expecter) UpdateNews(newsId interface{}, updateFields interface{}, categories interface{}) *INewsRepository_UpdateNews_Call {
-	return &INewsRepository_UpdateNews_Call{Call: _e.mock.On("UpdateNews", newsId, updateFields, categories)}
+func (_e *INewsRepository_Expecter) DeleteNews(newsId interface{}) *INewsRepository_DeleteNews_Call {
+	return &INewsRepository_DeleteNews_Call{Call: _e.mock.On("DeleteNews", newsId)}
 }
 
-func (_c *INewsRepository_UpdateNews_Call) Run(run func(newsId int64, updateFields map[string]interface{}, categories *[]int64)) *INewsRepository_UpdateNews_Call {
+func (_c *INewsRepository_DeleteNews_Call) Run(run func(newsId int64)) *INewsRepository_DeleteNews_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int64), args[1].(map[string]interface{}), args[2].(*[]int64))
+		run(args[0].(int64))
 	})
 	return _c
 }
 
-func (_c *INewsRepository_UpdateNews_Call) Return(_a0 error) *INewsRepository_UpdateNews_Call {
+func (_c *INewsRepository_DeleteNews_Call) Return(_a0 error) *INewsRepository_DeleteNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsRepository_DeleteNews_Call) RunAndReturn(run func(int64) error) *INewsRepository_DeleteNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTranslation provides a mock function with given fields: newsId, locale
+func (_m *INewsRepository) DeleteTranslation(newsId int64, locale string) error {
+	ret := _m.Called(newsId, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTranslation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, string) error); ok {
+		r0 = rf(newsId, locale)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsRepository_DeleteTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTranslation'
+type INewsRepository_DeleteTranslation_Call struct {
+	*mock.Call
+}
+
+// DeleteTranslation is a helper method to define mock.On call
+//   - newsId int64
+//   - locale string
+func (_e *INewsRepository_Expecter) DeleteTranslation(newsId interface{}, locale interface{}) *INewsRepository_DeleteTranslation_Call {
+	return &INewsRepository_DeleteTranslation_Call{Call: _e.mock.On("DeleteTranslation", newsId, locale)}
+}
+
+func (_c *INewsRepository_DeleteTranslation_Call) Run(run func(newsId int64, locale string)) *INewsRepository_DeleteTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_DeleteTranslation_Call) Return(_a0 error) *INewsRepository_DeleteTranslation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsRepository_DeleteTranslation_Call) RunAndReturn(run func(int64, string) error) *INewsRepository_DeleteTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EstimateNews provides a mock function with given fields: filter
+func (_m *INewsRepository) EstimateNews(filter models.NewsFilter) (int64, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateNews")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.NewsFilter) (int64, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.NewsFilter) int64); ok {
+		r0 = rf(filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.NewsFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_EstimateNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimateNews'
+type INewsRepository_EstimateNews_Call struct {
+	*mock.Call
+}
+
+// EstimateNews is a helper method to define mock.On call
+//   - filter models.NewsFilter
+func (_e *INewsRepository_Expecter) EstimateNews(filter interface{}) *INewsRepository_EstimateNews_Call {
+	return &INewsRepository_EstimateNews_Call{Call: _e.mock.On("EstimateNews", filter)}
+}
+
+func (_c *INewsRepository_EstimateNews_Call) Run(run func(filter models.NewsFilter)) *INewsRepository_EstimateNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.NewsFilter))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_EstimateNews_Call) Return(_a0 int64, _a1 error) *INewsRepository_EstimateNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_EstimateNews_Call) RunAndReturn(run func(models.NewsFilter) (int64, error)) *INewsRepository_EstimateNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportNews provides a mock function with given fields: ctx, filter, fn
+func (_m *INewsRepository) ExportNews(ctx context.Context, filter models.NewsFilter, fn func(models.NewsWithCategories) error) error {
+	ret := _m.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportNews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.NewsFilter, func(models.NewsWithCategories) error) error); ok {
+		r0 = rf(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsRepository_ExportNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportNews'
+type INewsRepository_ExportNews_Call struct {
+	*mock.Call
+}
+
+// ExportNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.NewsFilter
+//   - fn func(models.NewsWithCategories) error
+func (_e *INewsRepository_Expecter) ExportNews(ctx interface{}, filter interface{}, fn interface{}) *INewsRepository_ExportNews_Call {
+	return &INewsRepository_ExportNews_Call{Call: _e.mock.On("ExportNews", ctx, filter, fn)}
+}
+
+func (_c *INewsRepository_ExportNews_Call) Run(run func(ctx context.Context, filter models.NewsFilter, fn func(models.NewsWithCategories) error)) *INewsRepository_ExportNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.NewsFilter), args[2].(func(models.NewsWithCategories) error))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_ExportNews_Call) Return(_a0 error) *INewsRepository_ExportNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsRepository_ExportNews_Call) RunAndReturn(run func(context.Context, models.NewsFilter, func(models.NewsWithCategories) error) error) *INewsRepository_ExportNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNews provides a mock function with given fields: limit, offset, cursor, filter, sort
+func (_m *INewsRepository) GetNews(limit int64, offset int64, cursor *models.NewsCursor, filter models.NewsFilter, sort models.NewsSort) ([]models.NewsWithCategories, error) {
+	ret := _m.Called(limit, offset, cursor, filter, sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNews")
+	}
+
+	var r0 []models.NewsWithCategories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.NewsCursor, models.NewsFilter, models.NewsSort) ([]models.NewsWithCategories, error)); ok {
+		return rf(limit, offset, cursor, filter, sort)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.NewsCursor, models.NewsFilter, models.NewsSort) []models.NewsWithCategories); ok {
+		r0 = rf(limit, offset, cursor, filter, sort)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NewsWithCategories)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, *models.NewsCursor, models.NewsFilter, models.NewsSort) error); ok {
+		r1 = rf(limit, offset, cursor, filter, sort)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_GetNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNews'
+type INewsRepository_GetNews_Call struct {
+	*mock.Call
+}
+
+// GetNews is a helper method to define mock.On call
+//   - limit int64
+//   - offset int64
+//   - cursor *models.NewsCursor
+//   - filter models.NewsFilter
+//   - sort models.NewsSort
+func (_e *INewsRepository_Expecter) GetNews(limit interface{}, offset interface{}, cursor interface{}, filter interface{}, sort interface{}) *INewsRepository_GetNews_Call {
+	return &INewsRepository_GetNews_Call{Call: _e.mock.On("GetNews", limit, offset, cursor, filter, sort)}
+}
+
+func (_c *INewsRepository_GetNews_Call) Run(run func(limit int64, offset int64, cursor *models.NewsCursor, filter models.NewsFilter, sort models.NewsSort)) *INewsRepository_GetNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].(*models.NewsCursor), args[3].(models.NewsFilter), args[4].(models.NewsSort))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_GetNews_Call) Return(_a0 []models.NewsWithCategories, _a1 error) *INewsRepository_GetNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_GetNews_Call) RunAndReturn(run func(int64, int64, *models.NewsCursor, models.NewsFilter, models.NewsSort) ([]models.NewsWithCategories, error)) *INewsRepository_GetNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNewsByID provides a mock function with given fields: newsId
+func (_m *INewsRepository) GetNewsByID(newsId int64) (models.NewsWithCategories, error) {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNewsByID")
+	}
+
+	var r0 models.NewsWithCategories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (models.NewsWithCategories, error)); ok {
+		return rf(newsId)
+	}
+	if rf, ok := ret.Get(0).(func(int64) models.NewsWithCategories); ok {
+		r0 = rf(newsId)
+	} else {
+		r0 = ret.Get(0).(models.NewsWithCategories)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(newsId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_GetNewsByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewsByID'
+type INewsRepository_GetNewsByID_Call struct {
+	*mock.Call
+}
+
+// GetNewsByID is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsRepository_Expecter) GetNewsByID(newsId interface{}) *INewsRepository_GetNewsByID_Call {
+	return &INewsRepository_GetNewsByID_Call{Call: _e.mock.On("GetNewsByID", newsId)}
+}
+
+func (_c *INewsRepository_GetNewsByID_Call) Run(run func(newsId int64)) *INewsRepository_GetNewsByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_GetNewsByID_Call) Return(_a0 models.NewsWithCategories, _a1 error) *INewsRepository_GetNewsByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_GetNewsByID_Call) RunAndReturn(run func(int64) (models.NewsWithCategories, error)) *INewsRepository_GetNewsByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentlyPublished provides a mock function with given fields: since, limit
+func (_m *INewsRepository) GetRecentlyPublished(since time.Time, limit int64) ([]models.SitemapEntry, error) {
+	ret := _m.Called(since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentlyPublished")
+	}
+
+	var r0 []models.SitemapEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int64) ([]models.SitemapEntry, error)); ok {
+		return rf(since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int64) []models.SitemapEntry); ok {
+		r0 = rf(since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SitemapEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int64) error); ok {
+		r1 = rf(since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_GetRecentlyPublished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentlyPublished'
+type INewsRepository_GetRecentlyPublished_Call struct {
+	*mock.Call
+}
+
+// GetRecentlyPublished is a helper method to define mock.On call
+//   - since time.Time
+//   - limit int64
+func (_e *INewsRepository_Expecter) GetRecentlyPublished(since interface{}, limit interface{}) *INewsRepository_GetRecentlyPublished_Call {
+	return &INewsRepository_GetRecentlyPublished_Call{Call: _e.mock.On("GetRecentlyPublished", since, limit)}
+}
+
+func (_c *INewsRepository_GetRecentlyPublished_Call) Run(run func(since time.Time, limit int64)) *INewsRepository_GetRecentlyPublished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_GetRecentlyPublished_Call) Return(_a0 []models.SitemapEntry, _a1 error) *INewsRepository_GetRecentlyPublished_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_GetRecentlyPublished_Call) RunAndReturn(run func(time.Time, int64) ([]models.SitemapEntry, error)) *INewsRepository_GetRecentlyPublished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRelatedNews provides a mock function with given fields: newsId, limit, weights
+func (_m *INewsRepository) GetRelatedNews(newsId int64, limit int64, weights models.RelatedWeights) ([]models.RelatedNews, error) {
+	ret := _m.Called(newsId, limit, weights)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelatedNews")
+	}
+
+	var r0 []models.RelatedNews
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64, models.RelatedWeights) ([]models.RelatedNews, error)); ok {
+		return rf(newsId, limit, weights)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64, models.RelatedWeights) []models.RelatedNews); ok {
+		r0 = rf(newsId, limit, weights)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RelatedNews)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, models.RelatedWeights) error); ok {
+		r1 = rf(newsId, limit, weights)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_GetRelatedNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRelatedNews'
+type INewsRepository_GetRelatedNews_Call struct {
+	*mock.Call
+}
+
+// GetRelatedNews is a helper method to define mock.On call
+//   - newsId int64
+//   - limit int64
+//   - weights models.RelatedWeights
+func (_e *INewsRepository_Expecter) GetRelatedNews(newsId interface{}, limit interface{}, weights interface{}) *INewsRepository_GetRelatedNews_Call {
+	return &INewsRepository_GetRelatedNews_Call{Call: _e.mock.On("GetRelatedNews", newsId, limit, weights)}
+}
+
+func (_c *INewsRepository_GetRelatedNews_Call) Run(run func(newsId int64, limit int64, weights models.RelatedWeights)) *INewsRepository_GetRelatedNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].(models.RelatedWeights))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_GetRelatedNews_Call) Return(_a0 []models.RelatedNews, _a1 error) *INewsRepository_GetRelatedNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_GetRelatedNews_Call) RunAndReturn(run func(int64, int64, models.RelatedWeights) ([]models.RelatedNews, error)) *INewsRepository_GetRelatedNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevision provides a mock function with given fields: newsId, revision
+func (_m *INewsRepository) GetRevision(newsId int64, revision int64) (models.NewsRevision, error) {
+	ret := _m.Called(newsId, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 models.NewsRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) (models.NewsRevision, error)); ok {
+		return rf(newsId, revision)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) models.NewsRevision); ok {
+		r0 = rf(newsId, revision)
+	} else {
+		r0 = ret.Get(0).(models.NewsRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(newsId, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type INewsRepository_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - newsId int64
+//   - revision int64
+func (_e *INewsRepository_Expecter) GetRevision(newsId interface{}, revision interface{}) *INewsRepository_GetRevision_Call {
+	return &INewsRepository_GetRevision_Call{Call: _e.mock.On("GetRevision", newsId, revision)}
+}
+
+func (_c *INewsRepository_GetRevision_Call) Run(run func(newsId int64, revision int64)) *INewsRepository_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_GetRevision_Call) Return(_a0 models.NewsRevision, _a1 error) *INewsRepository_GetRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_GetRevision_Call) RunAndReturn(run func(int64, int64) (models.NewsRevision, error)) *INewsRepository_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisions provides a mock function with given fields: newsId
+func (_m *INewsRepository) GetRevisions(newsId int64) ([]models.NewsRevision, error) {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisions")
+	}
+
+	var r0 []models.NewsRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]models.NewsRevision, error)); ok {
+		return rf(newsId)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []models.NewsRevision); ok {
+		r0 = rf(newsId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NewsRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(newsId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type INewsRepository_GetRevisions_Call struct {
+	*mock.Call
+}
+
+// GetRevisions is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsRepository_Expecter) GetRevisions(newsId interface{}) *INewsRepository_GetRevisions_Call {
+	return &INewsRepository_GetRevisions_Call{Call: _e.mock.On("GetRevisions", newsId)}
+}
+
+func (_c *INewsRepository_GetRevisions_Call) Run(run func(newsId int64)) *INewsRepository_GetRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_GetRevisions_Call) Return(_a0 []models.NewsRevision, _a1 error) *INewsRepository_GetRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_GetRevisions_Call) RunAndReturn(run func(int64) ([]models.NewsRevision, error)) *INewsRepository_GetRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSitemapChunks provides a mock function with given fields: chunkSize
+func (_m *INewsRepository) GetSitemapChunks(chunkSize int64) ([]models.SitemapChunk, error) {
+	ret := _m.Called(chunkSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSitemapChunks")
+	}
+
+	var r0 []models.SitemapChunk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]models.SitemapChunk, error)); ok {
+		return rf(chunkSize)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []models.SitemapChunk); ok {
+		r0 = rf(chunkSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SitemapChunk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(chunkSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_GetSitemapChunks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSitemapChunks'
+type INewsRepository_GetSitemapChunks_Call struct {
+	*mock.Call
+}
+
+// GetSitemapChunks is a helper method to define mock.On call
+//   - chunkSize int64
+func (_e *INewsRepository_Expecter) GetSitemapChunks(chunkSize interface{}) *INewsRepository_GetSitemapChunks_Call {
+	return &INewsRepository_GetSitemapChunks_Call{Call: _e.mock.On("GetSitemapChunks", chunkSize)}
+}
+
+func (_c *INewsRepository_GetSitemapChunks_Call) Run(run func(chunkSize int64)) *INewsRepository_GetSitemapChunks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_GetSitemapChunks_Call) Return(_a0 []models.SitemapChunk, _a1 error) *INewsRepository_GetSitemapChunks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_GetSitemapChunks_Call) RunAndReturn(run func(int64) ([]models.SitemapChunk, error)) *INewsRepository_GetSitemapChunks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSitemapEntries provides a mock function with given fields: chunk, chunkSize
+func (_m *INewsRepository) GetSitemapEntries(chunk int64, chunkSize int64) ([]models.SitemapEntry, error) {
+	ret := _m.Called(chunk, chunkSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSitemapEntries")
+	}
+
+	var r0 []models.SitemapEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) ([]models.SitemapEntry, error)); ok {
+		return rf(chunk, chunkSize)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) []models.SitemapEntry); ok {
+		r0 = rf(chunk, chunkSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SitemapEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(chunk, chunkSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_GetSitemapEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSitemapEntries'
+type INewsRepository_GetSitemapEntries_Call struct {
+	*mock.Call
+}
+
+// GetSitemapEntries is a helper method to define mock.On call
+//   - chunk int64
+//   - chunkSize int64
+func (_e *INewsRepository_Expecter) GetSitemapEntries(chunk interface{}, chunkSize interface{}) *INewsRepository_GetSitemapEntries_Call {
+	return &INewsRepository_GetSitemapEntries_Call{Call: _e.mock.On("GetSitemapEntries", chunk, chunkSize)}
+}
+
+func (_c *INewsRepository_GetSitemapEntries_Call) Run(run func(chunk int64, chunkSize int64)) *INewsRepository_GetSitemapEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_GetSitemapEntries_Call) Return(_a0 []models.SitemapEntry, _a1 error) *INewsRepository_GetSitemapEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_GetSitemapEntries_Call) RunAndReturn(run func(int64, int64) ([]models.SitemapEntry, error)) *INewsRepository_GetSitemapEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTranslations provides a mock function with given fields: newsIDs, locales
+func (_m *INewsRepository) GetTranslations(newsIDs []int64, locales []string) ([]models.NewsTranslation, error) {
+	ret := _m.Called(newsIDs, locales)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTranslations")
+	}
+
+	var r0 []models.NewsTranslation
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int64, []string) ([]models.NewsTranslation, error)); ok {
+		return rf(newsIDs, locales)
+	}
+	if rf, ok := ret.Get(0).(func([]int64, []string) []models.NewsTranslation); ok {
+		r0 = rf(newsIDs, locales)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NewsTranslation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int64, []string) error); ok {
+		r1 = rf(newsIDs, locales)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_GetTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTranslations'
+type INewsRepository_GetTranslations_Call struct {
+	*mock.Call
+}
+
+// GetTranslations is a helper method to define mock.On call
+//   - newsIDs []int64
+//   - locales []string
+func (_e *INewsRepository_Expecter) GetTranslations(newsIDs interface{}, locales interface{}) *INewsRepository_GetTranslations_Call {
+	return &INewsRepository_GetTranslations_Call{Call: _e.mock.On("GetTranslations", newsIDs, locales)}
+}
+
+func (_c *INewsRepository_GetTranslations_Call) Run(run func(newsIDs []int64, locales []string)) *INewsRepository_GetTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]int64), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_GetTranslations_Call) Return(_a0 []models.NewsTranslation, _a1 error) *INewsRepository_GetTranslations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_GetTranslations_Call) RunAndReturn(run func([]int64, []string) ([]models.NewsTranslation, error)) *INewsRepository_GetTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrash provides a mock function with given fields: limit, offset
+func (_m *INewsRepository) GetTrash(limit int64, offset int64) ([]models.NewsWithCategories, error) {
+	ret := _m.Called(limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrash")
+	}
+
+	var r0 []models.NewsWithCategories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) ([]models.NewsWithCategories, error)); ok {
+		return rf(limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) []models.NewsWithCategories); ok {
+		r0 = rf(limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NewsWithCategories)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_GetTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrash'
+type INewsRepository_GetTrash_Call struct {
+	*mock.Call
+}
+
+// GetTrash is a helper method to define mock.On call
+//   - limit int64
+//   - offset int64
+func (_e *INewsRepository_Expecter) GetTrash(limit interface{}, offset interface{}) *INewsRepository_GetTrash_Call {
+	return &INewsRepository_GetTrash_Call{Call: _e.mock.On("GetTrash", limit, offset)}
+}
+
+func (_c *INewsRepository_GetTrash_Call) Run(run func(limit int64, offset int64)) *INewsRepository_GetTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_GetTrash_Call) Return(_a0 []models.NewsWithCategories, _a1 error) *INewsRepository_GetTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_GetTrash_Call) RunAndReturn(run func(int64, int64) ([]models.NewsWithCategories, error)) *INewsRepository_GetTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeNews provides a mock function with given fields: deletedBefore
+func (_m *INewsRepository) PurgeNews(deletedBefore time.Time) (int64, error) {
+	ret := _m.Called(deletedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeNews")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return rf(deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = rf(deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_PurgeNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeNews'
+type INewsRepository_PurgeNews_Call struct {
+	*mock.Call
+}
+
+// PurgeNews is a helper method to define mock.On call
+//   - deletedBefore time.Time
+func (_e *INewsRepository_Expecter) PurgeNews(deletedBefore interface{}) *INewsRepository_PurgeNews_Call {
+	return &INewsRepository_PurgeNews_Call{Call: _e.mock.On("PurgeNews", deletedBefore)}
+}
+
+func (_c *INewsRepository_PurgeNews_Call) Run(run func(deletedBefore time.Time)) *INewsRepository_PurgeNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_PurgeNews_Call) Return(_a0 int64, _a1 error) *INewsRepository_PurgeNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_PurgeNews_Call) RunAndReturn(run func(time.Time) (int64, error)) *INewsRepository_PurgeNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveNewsCategory provides a mock function with given fields: newsId, categoryId, editor
+func (_m *INewsRepository) RemoveNewsCategory(newsId int64, categoryId int64, editor string) (models.NewsWithCategories, error) {
+	ret := _m.Called(newsId, categoryId, editor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveNewsCategory")
+	}
+
+	var r0 models.NewsWithCategories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64, string) (models.NewsWithCategories, error)); ok {
+		return rf(newsId, categoryId, editor)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64, string) models.NewsWithCategories); ok {
+		r0 = rf(newsId, categoryId, editor)
+	} else {
+		r0 = ret.Get(0).(models.NewsWithCategories)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, string) error); ok {
+		r1 = rf(newsId, categoryId, editor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_RemoveNewsCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveNewsCategory'
+type INewsRepository_RemoveNewsCategory_Call struct {
+	*mock.Call
+}
+
+// RemoveNewsCategory is a helper method to define mock.On call
+//   - newsId int64
+//   - categoryId int64
+//   - editor string
+func (_e *INewsRepository_Expecter) RemoveNewsCategory(newsId interface{}, categoryId interface{}, editor interface{}) *INewsRepository_RemoveNewsCategory_Call {
+	return &INewsRepository_RemoveNewsCategory_Call{Call: _e.mock.On("RemoveNewsCategory", newsId, categoryId, editor)}
+}
+
+func (_c *INewsRepository_RemoveNewsCategory_Call) Run(run func(newsId int64, categoryId int64, editor string)) *INewsRepository_RemoveNewsCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_RemoveNewsCategory_Call) Return(_a0 models.NewsWithCategories, _a1 error) *INewsRepository_RemoveNewsCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_RemoveNewsCategory_Call) RunAndReturn(run func(int64, int64, string) (models.NewsWithCategories, error)) *INewsRepository_RemoveNewsCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreNews provides a mock function with given fields: newsId
+func (_m *INewsRepository) RestoreNews(newsId int64) error {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreNews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(newsId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsRepository_RestoreNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreNews'
+type INewsRepository_RestoreNews_Call struct {
+	*mock.Call
+}
+
+// RestoreNews is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsRepository_Expecter) RestoreNews(newsId interface{}) *INewsRepository_RestoreNews_Call {
+	return &INewsRepository_RestoreNews_Call{Call: _e.mock.On("RestoreNews", newsId)}
+}
+
+func (_c *INewsRepository_RestoreNews_Call) Run(run func(newsId int64)) *INewsRepository_RestoreNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_RestoreNews_Call) Return(_a0 error) *INewsRepository_RestoreNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsRepository_RestoreNews_Call) RunAndReturn(run func(int64) error) *INewsRepository_RestoreNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunScheduledTransitions provides a mock function with given fields: now
+func (_m *INewsRepository) RunScheduledTransitions(now time.Time) (models.ScheduleResult, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunScheduledTransitions")
+	}
+
+	var r0 models.ScheduleResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (models.ScheduleResult, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) models.ScheduleResult); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Get(0).(models.ScheduleResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_RunScheduledTransitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunScheduledTransitions'
+type INewsRepository_RunScheduledTransitions_Call struct {
+	*mock.Call
+}
+
+// RunScheduledTransitions is a helper method to define mock.On call
+//   - now time.Time
+func (_e *INewsRepository_Expecter) RunScheduledTransitions(now interface{}) *INewsRepository_RunScheduledTransitions_Call {
+	return &INewsRepository_RunScheduledTransitions_Call{Call: _e.mock.On("RunScheduledTransitions", now)}
+}
+
+func (_c *INewsRepository_RunScheduledTransitions_Call) Run(run func(now time.Time)) *INewsRepository_RunScheduledTransitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_RunScheduledTransitions_Call) Return(_a0 models.ScheduleResult, _a1 error) *INewsRepository_RunScheduledTransitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_RunScheduledTransitions_Call) RunAndReturn(run func(time.Time) (models.ScheduleResult, error)) *INewsRepository_RunScheduledTransitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTranslation provides a mock function with given fields: newsId, locale, title, content, expectedVersion
+func (_m *INewsRepository) SaveTranslation(newsId int64, locale string, title *string, content *string, expectedVersion *int64) error {
+	ret := _m.Called(newsId, locale, title, content, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTranslation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, string, *string, *string, *int64) error); ok {
+		r0 = rf(newsId, locale, title, content, expectedVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsRepository_SaveTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTranslation'
+type INewsRepository_SaveTranslation_Call struct {
+	*mock.Call
+}
+
+// SaveTranslation is a helper method to define mock.On call
+//   - newsId int64
+//   - locale string
+//   - title *string
+//   - content *string
+//   - expectedVersion *int64
+func (_e *INewsRepository_Expecter) SaveTranslation(newsId interface{}, locale interface{}, title interface{}, content interface{}, expectedVersion interface{}) *INewsRepository_SaveTranslation_Call {
+	return &INewsRepository_SaveTranslation_Call{Call: _e.mock.On("SaveTranslation", newsId, locale, title, content, expectedVersion)}
+}
+
+func (_c *INewsRepository_SaveTranslation_Call) Run(run func(newsId int64, locale string, title *string, content *string, expectedVersion *int64)) *INewsRepository_SaveTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(string), args[2].(*string), args[3].(*string), args[4].(*int64))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_SaveTranslation_Call) Return(_a0 error) *INewsRepository_SaveTranslation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsRepository_SaveTranslation_Call) RunAndReturn(run func(int64, string, *string, *string, *int64) error) *INewsRepository_SaveTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchNews provides a mock function with given fields: query, limit, offset, filter, sort
+func (_m *INewsRepository) SearchNews(query string, limit int64, offset int64, filter models.NewsFilter, sort models.NewsSort) ([]models.NewsSearchResult, error) {
+	ret := _m.Called(query, limit, offset, filter, sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchNews")
+	}
+
+	var r0 []models.NewsSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64, models.NewsFilter, models.NewsSort) ([]models.NewsSearchResult, error)); ok {
+		return rf(query, limit, offset, filter, sort)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64, models.NewsFilter, models.NewsSort) []models.NewsSearchResult); ok {
+		r0 = rf(query, limit, offset, filter, sort)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NewsSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64, models.NewsFilter, models.NewsSort) error); ok {
+		r1 = rf(query, limit, offset, filter, sort)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsRepository_SearchNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchNews'
+type INewsRepository_SearchNews_Call struct {
+	*mock.Call
+}
+
+// SearchNews is a helper method to define mock.On call
+//   - query string
+//   - limit int64
+//   - offset int64
+//   - filter models.NewsFilter
+//   - sort models.NewsSort
+func (_e *INewsRepository_Expecter) SearchNews(query interface{}, limit interface{}, offset interface{}, filter interface{}, sort interface{}) *INewsRepository_SearchNews_Call {
+	return &INewsRepository_SearchNews_Call{Call: _e.mock.On("SearchNews", query, limit, offset, filter, sort)}
+}
+
+func (_c *INewsRepository_SearchNews_Call) Run(run func(query string, limit int64, offset int64, filter models.NewsFilter, sort models.NewsSort)) *INewsRepository_SearchNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(int64), args[3].(models.NewsFilter), args[4].(models.NewsSort))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_SearchNews_Call) Return(_a0 []models.NewsSearchResult, _a1 error) *INewsRepository_SearchNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsRepository_SearchNews_Call) RunAndReturn(run func(string, int64, int64, models.NewsFilter, models.NewsSort) ([]models.NewsSearchResult, error)) *INewsRepository_SearchNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNews provides a mock function with given fields: newsId, updateFields, categories, media, expectedVersion, editor
+func (_m *INewsRepository) UpdateNews(newsId int64, updateFields map[string]interface{}, categories *[]int64, media *[]int64, expectedVersion *int64, editor string) error {
+	ret := _m.Called(newsId, updateFields, categories, media, expectedVersion, editor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, map[string]interface{}, *[]int64, *[]int64, *int64, string) error); ok {
+		r0 = rf(newsId, updateFields, categories, media, expectedVersion, editor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsRepository_UpdateNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNews'
+type INewsRepository_UpdateNews_Call struct {
+	*mock.Call
+}
+
+// UpdateNews is a helper method to define mock.On call
+//   - newsId int64
+//   - updateFields map[string]interface{}
+//   - categories *[]int64
+//   - media *[]int64
+//   - expectedVersion *int64
+//   - editor string
+func (_e *INewsRepository_Expecter) UpdateNews(newsId interface{}, updateFields interface{}, categories interface{}, media interface{}, expectedVersion interface{}, editor interface{}) *INewsRepository_UpdateNews_Call {
+	return &INewsRepository_UpdateNews_Call{Call: _e.mock.On("UpdateNews", newsId, updateFields, categories, media, expectedVersion, editor)}
+}
+
+func (_c *INewsRepository_UpdateNews_Call) Run(run func(newsId int64, updateFields map[string]interface{}, categories *[]int64, media *[]int64, expectedVersion *int64, editor string)) *INewsRepository_UpdateNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(map[string]interface{}), args[2].(*[]int64), args[3].(*[]int64), args[4].(*int64), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_UpdateNews_Call) Return(_a0 error) *INewsRepository_UpdateNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsRepository_UpdateNews_Call) RunAndReturn(run func(int64, map[string]interface{}, *[]int64, *[]int64, *int64, string) error) *INewsRepository_UpdateNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNewsStatus provides a mock function with given fields: newsId, allowedFrom, status
+func (_m *INewsRepository) UpdateNewsStatus(newsId int64, allowedFrom []string, status string) error {
+	ret := _m.Called(newsId, allowedFrom, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNewsStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, []string, string) error); ok {
+		r0 = rf(newsId, allowedFrom, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsRepository_UpdateNewsStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNewsStatus'
+type INewsRepository_UpdateNewsStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateNewsStatus is a helper method to define mock.On call
+//   - newsId int64
+//   - allowedFrom []string
+//   - status string
+func (_e *INewsRepository_Expecter) UpdateNewsStatus(newsId interface{}, allowedFrom interface{}, status interface{}) *INewsRepository_UpdateNewsStatus_Call {
+	return &INewsRepository_UpdateNewsStatus_Call{Call: _e.mock.On("UpdateNewsStatus", newsId, allowedFrom, status)}
+}
+
+func (_c *INewsRepository_UpdateNewsStatus_Call) Run(run func(newsId int64, allowedFrom []string, status string)) *INewsRepository_UpdateNewsStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].([]string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *INewsRepository_UpdateNewsStatus_Call) Return(_a0 error) *INewsRepository_UpdateNewsStatus_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *INewsRepository_UpdateNews_Call) RunAndReturn(run func(int64, map[string]interface{}, *[]int64) error) *INewsRepository_UpdateNews_Call {
+func (_c *INewsRepository_UpdateNewsStatus_Call) RunAndReturn(run func(int64, []string, string) error) *INewsRepository_UpdateNewsStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+	"service/internal/models"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/reform.v1"
+)
+
+var (
+	//go:embed sql/declare_news_export.sql
+	SqlDeclareNewsExport string
+	//go:embed sql/fetch_news_export.sql
+	SqlFetchNewsExport string
+)
+
+// ExportNews passes the news matching filter to fn in ID order. Rows are read through a server-side cursor
+// in batches, so memory use does not grow with the export. An error from fn or a cancelled ctx stops the export.
+func (r *NewsRepository) ExportNews(ctx context.Context, filter models.NewsFilter, fn func(models.NewsWithCategories) error) error {
+	const op = "repository.news.ExportNews"
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	args := queryArgs{}
+	where := newsConditions(filter, &args)
+
+	if _, err = tx.ExecContext(ctx, fmt.Sprintf(SqlDeclareNewsExport, where), args...); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to declare export cursor")
+		return fmt.Errorf("failed to declare export cursor: %w", err)
+	}
+
+	var exported int
+	for {
+		fetched, err := r.fetchExportBatch(ctx, tx, op, fn)
+		if err != nil {
+			return err
+		}
+		if fetched == 0 {
+			break
+		}
+		exported += fetched
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"exported":  exported,
+	}).Info("News exported")
+
+	return nil
+}
+
+// fetchExportBatch passes the next batch of the export cursor to fn and returns its size, 0 at the end.
+func (r *NewsRepository) fetchExportBatch(ctx context.Context, tx *reform.TX, op string, fn func(models.NewsWithCategories) error) (int, error) {
+	rows, err := tx.QueryContext(ctx, SqlFetchNewsExport)
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to fetch news")
+		return 0, fmt.Errorf("failed to fetch news: %w", err)
+	}
+	defer rows.Close()
+
+	var fetched int
+	for rows.Next() {
+		n, err := scanNewsWithCategories(rows)
+		if err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan news row")
+			return 0, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if err = fn(n); err != nil {
+			return 0, err
+		}
+		fetched++
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating news rows")
+		return 0, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return fetched, nil
+}
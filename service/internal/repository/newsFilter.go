@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"fmt"
+	"service/internal/models"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// queryArgs collects positional arguments of a query built at runtime.
+type queryArgs []interface{}
+
+// add appends value to the arguments and returns its placeholder.
+func (a *queryArgs) add(value interface{}) string {
+	*a = append(*a, value)
+	return fmt.Sprintf("$%d", len(*a))
+}
+
+// likeEscaper escapes LIKE wildcards so user input is matched literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// newsConditions translates filter into SQL conditions on news aliased as n.
+// Filter values are never written into the SQL text, they are passed through args.
+func newsConditions(filter models.NewsFilter, args *queryArgs) string {
+	conditions := []string{"n.deleted_at IS NULL"}
+
+	if len(filter.Statuses) > 0 {
+		conditions = append(conditions, fmt.Sprintf("n.status = ANY (%s::TEXT[])", args.add(pq.Array(filter.Statuses))))
+	}
+
+	depth := "cc.depth = 0"
+	if filter.IncludeDescendants {
+		depth = "TRUE"
+	}
+
+	if len(filter.CategoryIDs) > 0 {
+		ids := args.add(pq.Array(filter.CategoryIDs))
+		if filter.CategoryMode == models.CategoryModeAll {
+			conditions = append(conditions, fmt.Sprintf(`(SELECT COUNT(DISTINCT cc.ancestor_id)
+      FROM news_categories fnc
+               JOIN category_closure cc ON cc.descendant_id = fnc.category_id
+      WHERE fnc.news_id = n.id
+        AND cc.ancestor_id = ANY (%s::BIGINT[])
+        AND %s) = CARDINALITY(%s::BIGINT[])`, ids, depth, ids))
+		} else {
+			conditions = append(conditions, "EXISTS "+categoryMatch(ids, depth))
+		}
+	}
+
+	if len(filter.ExcludeCategoryIDs) > 0 {
+		conditions = append(conditions, "NOT EXISTS "+categoryMatch(args.add(pq.Array(filter.ExcludeCategoryIDs)), depth))
+	}
+
+	if filter.CreatedFrom != nil {
+		conditions = append(conditions, "n.created_at >= "+args.add(*filter.CreatedFrom))
+	}
+
+	if filter.CreatedTo != nil {
+		conditions = append(conditions, "n.created_at <= "+args.add(*filter.CreatedTo))
+	}
+
+	if filter.TitleContains != "" {
+		pattern := "%" + likeEscaper.Replace(filter.TitleContains) + "%"
+		conditions = append(conditions, fmt.Sprintf(`n.title ILIKE %s ESCAPE '\'`, args.add(pattern)))
+	}
+
+	return strings.Join(conditions, "\n  AND ")
+}
+
+func categoryMatch(ids, depth string) string {
+	return fmt.Sprintf(`(SELECT 1
+         FROM news_categories fnc
+                  JOIN category_closure cc ON cc.descendant_id = fnc.category_id
+         WHERE fnc.news_id = n.id
+           AND cc.ancestor_id = ANY (%s::BIGINT[])
+           AND %s)`, ids, depth)
+}
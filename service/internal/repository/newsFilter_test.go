@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"service/internal/models"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewsConditions(t *testing.T) {
+	t.Run("Success_empty", func(t *testing.T) {
+		args := queryArgs{10, 0}
+
+		where := newsConditions(models.NewsFilter{}, &args)
+
+		assert.Equal(t, "n.deleted_at IS NULL", where)
+		assert.Equal(t, queryArgs{10, 0}, args)
+	})
+
+	t.Run("Success_all filters", func(t *testing.T) {
+		createdFrom := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+		createdTo := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+		args := queryArgs{10, 0}
+
+		where := newsConditions(models.NewsFilter{
+			CategoryIDs:        []int64{1, 2},
+			CategoryMode:       models.CategoryModeAll,
+			ExcludeCategoryIDs: []int64{3},
+			IncludeDescendants: true,
+			Statuses:           []string{models.NewsStatusPublished},
+			CreatedFrom:        &createdFrom,
+			CreatedTo:          &createdTo,
+			TitleContains:      "50%_off",
+		}, &args)
+
+		assert.Contains(t, where, "n.status = ANY ($3::TEXT[])")
+		assert.Contains(t, where, "= CARDINALITY($4::BIGINT[])")
+		assert.Contains(t, where, "NOT EXISTS")
+		assert.Contains(t, where, "cc.ancestor_id = ANY ($5::BIGINT[])")
+		assert.Contains(t, where, "n.created_at >= $6")
+		assert.Contains(t, where, "n.created_at <= $7")
+		assert.Contains(t, where, `n.title ILIKE $8 ESCAPE '\'`)
+		assert.NotContains(t, where, "cc.depth = 0")
+		assert.Equal(t, queryArgs{
+			10, 0,
+			pq.Array([]string{models.NewsStatusPublished}),
+			pq.Array([]int64{1, 2}),
+			pq.Array([]int64{3}),
+			createdFrom,
+			createdTo,
+			`%50\%\_off%`,
+		}, args)
+	})
+
+	t.Run("Success_any mode without descendants", func(t *testing.T) {
+		args := queryArgs{10, 0}
+
+		where := newsConditions(models.NewsFilter{CategoryIDs: []int64{1}}, &args)
+
+		assert.Contains(t, where, "EXISTS (SELECT 1")
+		assert.Contains(t, where, "cc.depth = 0")
+		assert.NotContains(t, where, "CARDINALITY")
+	})
+
+	t.Run("Success_user input is not written into SQL", func(t *testing.T) {
+		args := queryArgs{}
+
+		where := newsConditions(models.NewsFilter{TitleContains: "'; DROP TABLE news; --"}, &args)
+
+		assert.NotContains(t, where, "DROP TABLE")
+	})
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	_ "embed"
+	"fmt"
+	"service/internal/apperrors"
+	"service/internal/models"
+
+	"github.com/sirupsen/logrus"
+)
+
+//go:embed sql/select_related_news.sql
+var SqlSelectRelatedNews string
+
+// GetRelatedNews returns up to limit published news related to news, best scored first. Candidates share
+// a category with news or have a similar title; the score is the weighted sum of the Jaccard overlap of
+// categories, the trigram similarity of titles and the recency, halving every weights.HalfLifeDays.
+func (r *NewsRepository) GetRelatedNews(newsId, limit int64, weights models.RelatedWeights) ([]models.RelatedNews, error) {
+	const op = "repository.news.GetRelatedNews"
+
+	rows, err := r.db.QueryContext(r.ctx, SqlSelectRelatedNews, newsId,
+		weights.Category, weights.Title, weights.Recency, weights.HalfLifeDays, limit)
+	if err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Error("Failed to select related news")
+		return nil, fmt.Errorf("failed to select related news: %w", err)
+	}
+	defer rows.Close()
+
+	related := make([]models.RelatedNews, 0)
+	for rows.Next() {
+		var result models.RelatedNews
+		var categories, media []int64
+
+		dest := append(newsScanDest(&result.NewsWithCategories, &categories, &media), &result.Score)
+		if err = rows.Scan(dest...); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan related news row")
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		result.Categories = nonNilIDs(categories)
+		result.Media = nonNilIDs(media)
+		related = append(related, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating related news rows")
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	if len(related) == 0 {
+		var exists bool
+		if err = r.db.QueryRowContext(r.ctx, SqlSelectNewsExists, newsId).Scan(&exists); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to check news existence")
+			return nil, fmt.Errorf("failed to check news existence: %w", err)
+		}
+		if !exists {
+			return nil, apperrors.NewNotFound("News not found")
+		}
+	}
+
+	return related, nil
+}
@@ -1,15 +1,21 @@
 package repository
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"service/internal/apperrors"
 	"service/internal/models"
+	"slices"
+	"strings"
+	"time"
 
 	"service/pkg/logger"
+	"service/pkg/markup"
 
 	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
@@ -17,19 +23,100 @@ import (
 )
 
 var (
-	//go:embed sql/select_news_by_limit_and_offset.sql
-	SqlSelectNewsByLimitAndOffset string
+	//go:embed sql/select_news_list.sql
+	SqlSelectNewsList string
+	//go:embed sql/select_news_by_id.sql
+	SqlSelectNewsByID string
+	//go:embed sql/count_news.sql
+	SqlCountNews string
+	//go:embed sql/estimate_news.sql
+	SqlEstimateNews string
+	//go:embed sql/search_news.sql
+	SqlSearchNews string
+	//go:embed sql/select_next_news_ids.sql
+	SqlSelectNextNewsIDs string
+	//go:embed sql/insert_news_bulk.sql
+	SqlInsertNewsBulk string
+	//go:embed sql/insert_news_categories_bulk.sql
+	SqlInsertNewsCategoriesBulk string
 	//go:embed sql/delete_news_categories.sql
 	SqlDeleteNewsCategories string
 	//go:embed sql/insert_news_categories.sql
 	SqlInsertNewsCategories string
+	//go:embed sql/select_trash_by_limit_and_offset.sql
+	SqlSelectTrashByLimitAndOffset string
+	//go:embed sql/soft_delete_news.sql
+	SqlSoftDeleteNews string
+	//go:embed sql/restore_news.sql
+	SqlRestoreNews string
+	//go:embed sql/select_purgeable_news_ids.sql
+	SqlSelectPurgeableNewsIDs string
+	//go:embed sql/delete_categories_by_news_ids.sql
+	SqlDeleteCategoriesByNewsIDs string
+	//go:embed sql/delete_news_by_ids.sql
+	SqlDeleteNewsByIDs string
+	//go:embed sql/try_advisory_xact_lock.sql
+	SqlTryAdvisoryXactLock string
+	//go:embed sql/set_scheduled_at.sql
+	SqlSetScheduledAt string
+	//go:embed sql/publish_scheduled_news.sql
+	SqlPublishScheduledNews string
+	//go:embed sql/archive_expired_news.sql
+	SqlArchiveExpiredNews string
+	//go:embed sql/select_bulk_news_ids.sql
+	SqlSelectBulkNewsIDs string
+	//go:embed sql/bulk_add_news_categories.sql
+	SqlBulkAddNewsCategories string
+	//go:embed sql/bulk_remove_news_categories.sql
+	SqlBulkRemoveNewsCategories string
+	//go:embed sql/bulk_replace_news_category.sql
+	SqlBulkReplaceNewsCategory string
+	//go:embed sql/bulk_set_news_status.sql
+	SqlBulkSetNewsStatus string
+	//go:embed sql/touch_news.sql
+	SqlTouchNews string
+	//go:embed sql/insert_news_media_bulk.sql
+	SqlInsertNewsMediaBulk string
+	//go:embed sql/delete_news_media.sql
+	SqlDeleteNewsMedia string
 )
 
+// bulkInsertRows caps the rows of one multi-row INSERT, keeping it far below the limit of 65535 parameters.
+const bulkInsertRows = 1000
+
+// schedulerLockKey is the advisory lock key that lets only one replica run the publication scheduler.
+const schedulerLockKey int64 = 7_120_251_220
+
 //go:generate mockery --name=INewsRepository --output=mocks --outpkg=mocks --case=snake --with-expecter
 type INewsRepository interface {
-	GetNews(limit, offset int64) ([]models.NewsWithCategories, error)
+	GetNews(limit, offset int64, cursor *models.NewsCursor, filter models.NewsFilter, sort models.NewsSort) ([]models.NewsWithCategories, error)
+	CountNews(filter models.NewsFilter) (int64, error)
+	EstimateNews(filter models.NewsFilter) (int64, error)
+	GetNewsByID(newsId int64) (models.NewsWithCategories, error)
+	SearchNews(query string, limit, offset int64, filter models.NewsFilter, sort models.NewsSort) ([]models.NewsSearchResult, error)
 	CreateNews(createForm models.NewsCreateForm) (int64, error)
-	UpdateNews(newsId int64, updateFields map[string]interface{}, categories *[]int64) error
+	CreateNewsBulk(createForms []models.NewsCreateForm, editor string) ([]int64, error)
+	UpdateNews(newsId int64, updateFields map[string]interface{}, categories, media *[]int64, expectedVersion *int64, editor string) error
+	DeleteNews(newsId int64) error
+	GetTrash(limit, offset int64) ([]models.NewsWithCategories, error)
+	RestoreNews(newsId int64) error
+	PurgeNews(deletedBefore time.Time) (int64, error)
+	UpdateNewsStatus(newsId int64, allowedFrom []string, status string) error
+	BulkEditNews(editForm models.NewsBulkEditForm, filter models.NewsFilter, allowedFrom []string) (int64, error)
+	AddNewsCategories(newsId int64, categoryIDs []int64, editor string) (models.NewsWithCategories, error)
+	RemoveNewsCategory(newsId, categoryId int64, editor string) (models.NewsWithCategories, error)
+	ExportNews(ctx context.Context, filter models.NewsFilter, fn func(models.NewsWithCategories) error) error
+	GetSitemapChunks(chunkSize int64) ([]models.SitemapChunk, error)
+	GetSitemapEntries(chunk, chunkSize int64) ([]models.SitemapEntry, error)
+	GetRecentlyPublished(since time.Time, limit int64) ([]models.SitemapEntry, error)
+	RunScheduledTransitions(now time.Time) (models.ScheduleResult, error)
+	GetRevisions(newsId int64) ([]models.NewsRevision, error)
+	GetRevision(newsId, revision int64) (models.NewsRevision, error)
+	GetTranslations(newsIDs []int64, locales []string) ([]models.NewsTranslation, error)
+	SaveTranslation(newsId int64, locale string, title, content *string, expectedVersion *int64) error
+	DeleteTranslation(newsId int64, locale string) error
+	CountTranslations(filter models.NewsFilter, locales []string) (map[string]int64, error)
+	GetRelatedNews(newsId, limit int64, weights models.RelatedWeights) ([]models.RelatedNews, error)
 }
 
 type NewsRepository struct {
@@ -46,44 +133,161 @@ func NewNewsRepository(db *reform.DB, log *logger.Logger, ctx context.Context) I
 	}
 }
 
-func (r *NewsRepository) GetNews(limit, offset int64) ([]models.NewsWithCategories, error) {
+// GetNews returns a page of news. With a cursor the page starts right after the news it points at
+// (or ends right before it for a backward cursor); news is always returned in the sort order.
+func (r *NewsRepository) GetNews(limit, offset int64, cursor *models.NewsCursor, filter models.NewsFilter, sort models.NewsSort) ([]models.NewsWithCategories, error) {
 	const op = "repository.news.GetNews"
 
-	rows, err := r.db.QueryContext(r.ctx, SqlSelectNewsByLimitAndOffset, limit, offset)
+	backward := cursor != nil && cursor.Backward
+
+	orderBy, err := newsOrderBy(sort, backward)
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Invalid sort order")
+		return nil, err
+	}
+
+	args := queryArgs{limit, offset}
+	where := newsConditions(filter, &args)
+
+	if cursor != nil {
+		keyset, err := newsKeyset(sort, *cursor, &args)
+		if err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Invalid cursor")
+			return nil, err
+		}
+		where += "\n  AND " + keyset
+	}
+
+	newsList, err := r.selectNewsList(op, fmt.Sprintf(SqlSelectNewsList, where, orderBy), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if backward {
+		slices.Reverse(newsList)
+	}
+
+	return newsList, nil
+}
+
+func (r *NewsRepository) CountNews(filter models.NewsFilter) (int64, error) {
+	const op = "repository.news.CountNews"
+
+	args := queryArgs{}
+	where := newsConditions(filter, &args)
+
+	var total int64
+	if err := r.db.QueryRowContext(r.ctx, fmt.Sprintf(SqlCountNews, where), args...).Scan(&total); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to count news")
+		return 0, fmt.Errorf("failed to count news: %w", err)
+	}
+
+	return total, nil
+}
+
+// EstimateNews returns the planner estimate of the news matching filter. It is derived from the
+// pg_class row count and column statistics, so it costs no table scan but lags behind until ANALYZE.
+func (r *NewsRepository) EstimateNews(filter models.NewsFilter) (int64, error) {
+	const op = "repository.news.EstimateNews"
+
+	args := queryArgs{}
+	where := newsConditions(filter, &args)
+
+	var plan []byte
+	if err := r.db.QueryRowContext(r.ctx, fmt.Sprintf(SqlEstimateNews, where), args...).Scan(&plan); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to estimate news count")
+		return 0, fmt.Errorf("failed to estimate news count: %w", err)
+	}
+
+	var explained []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &explained); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to parse query plan")
+		return 0, fmt.Errorf("failed to parse query plan: %w", err)
+	}
+	if len(explained) == 0 {
+		return 0, errors.New("failed to parse query plan: empty plan")
+	}
+
+	return int64(explained[0].Plan.Rows), nil
+}
+
+func (r *NewsRepository) SearchNews(query string, limit, offset int64, filter models.NewsFilter, sort models.NewsSort) ([]models.NewsSearchResult, error) {
+	const op = "repository.news.SearchNews"
+
+	orderBy, err := searchOrderBy(sort)
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Invalid sort order")
+		return nil, err
+	}
+
+	args := queryArgs{limit, offset, query}
+	where := newsConditions(filter, &args)
+
+	rows, err := r.db.QueryContext(r.ctx, fmt.Sprintf(SqlSearchNews, orderBy, where), args...)
 	if err != nil {
 		r.log.WithError(err).WithFields(logrus.Fields{
 			"operation": op,
-			"limit":     limit,
-			"offset":    offset,
-		}).Error("Failed to select news")
-		return nil, fmt.Errorf("failed to select news: %w", err)
+			"query":     query,
+		}).Error("Failed to search news")
+		return nil, fmt.Errorf("failed to search news: %w", err)
 	}
 	defer rows.Close()
 
-	newsList := make([]models.NewsWithCategories, 0)
+	results := make([]models.NewsSearchResult, 0)
 	for rows.Next() {
-		var n models.NewsWithCategories
-		var categories []int64
+		var result models.NewsSearchResult
+		var categories, media []int64
 
-		if err = rows.Scan(&n.ID, &n.Title, &n.Content, pq.Array(&categories)); err != nil {
-			r.log.WithError(err).WithField("operation", op).Error("Failed to scan news row")
+		dest := append(newsScanDest(&result.NewsWithCategories, &categories, &media),
+			&result.Rank, &result.TitleHighlight, &result.ContentHighlight)
+		if err = rows.Scan(dest...); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan search row")
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 
-		if categories == nil {
-			categories = []int64{}
-		}
-		n.Categories = categories
-
-		newsList = append(newsList, n)
+		result.Categories = nonNilIDs(categories)
+		result.Media = nonNilIDs(media)
+		result.TitleHighlight = markup.Highlight(result.TitleHighlight)
+		result.ContentHighlight = markup.Highlight(result.ContentHighlight)
+		results = append(results, result)
 	}
 
 	if err = rows.Err(); err != nil {
-		r.log.WithError(err).WithField("operation", op).Error("Error iterating news rows")
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating search rows")
 		return nil, fmt.Errorf("error iterating rows: %w", err)
 	}
 
-	return newsList, nil
+	return results, nil
+}
+
+func (r *NewsRepository) GetTrash(limit, offset int64) ([]models.NewsWithCategories, error) {
+	return r.selectNewsList("repository.news.GetTrash", SqlSelectTrashByLimitAndOffset, limit, offset)
+}
+
+func (r *NewsRepository) GetNewsByID(newsId int64) (models.NewsWithCategories, error) {
+	const op = "repository.news.GetNewsByID"
+
+	n, err := scanNewsWithCategories(r.db.QueryRowContext(r.ctx, SqlSelectNewsByID, newsId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.log.WithFields(logrus.Fields{
+				"operation": op,
+				"news_id":   newsId,
+			}).Warn("News not found")
+			return models.NewsWithCategories{}, apperrors.NewNotFound("News not found")
+		}
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Error("Failed to select news")
+		return models.NewsWithCategories{}, fmt.Errorf("failed to select news: %w", err)
+	}
+
+	return n, nil
 }
 
 func (r *NewsRepository) CreateNews(createForm models.NewsCreateForm) (int64, error) {
@@ -94,11 +298,15 @@ func (r *NewsRepository) CreateNews(createForm models.NewsCreateForm) (int64, er
 		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
 		return 0, fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	defer r.rollbackOnError(tx, op)
+	defer rollbackOnError(r.log, tx, op)
 
 	news := &models.News{
-		Title:   createForm.Title,
-		Content: createForm.Content,
+		Title:         createForm.Title,
+		Content:       createForm.Content,
+		ContentFormat: contentFormat(createForm.ContentFormat),
+		Status:        models.NewsStatusDraft,
+		PublishAt:     createForm.PublishAt,
+		ExpiresAt:     createForm.ExpiresAt,
 	}
 
 	if err = tx.Save(news); err != nil {
@@ -117,6 +325,16 @@ func (r *NewsRepository) CreateNews(createForm models.NewsCreateForm) (int64, er
 		}
 	}
 
+	if createForm.Media != nil {
+		if err = r.insertMedia(tx, newsID, *createForm.Media); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = r.insertRevision(tx, newsID, createForm.Editor); err != nil {
+		return 0, err
+	}
+
 	if err = tx.Commit(); err != nil {
 		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
 		return 0, fmt.Errorf("failed to commit transaction: %w", err)
@@ -130,7 +348,81 @@ func (r *NewsRepository) CreateNews(createForm models.NewsCreateForm) (int64, er
 	return newsID, nil
 }
 
-func (r *NewsRepository) UpdateNews(newsId int64, updateFields map[string]interface{}, categories *[]int64) error {
+// CreateNewsBulk inserts all news in one transaction with multi-row statements and returns their IDs in input order.
+// IDs are taken from the sequence up front, so they do not depend on the order rows are inserted in.
+func (r *NewsRepository) CreateNewsBulk(createForms []models.NewsCreateForm, editor string) ([]int64, error) {
+	const op = "repository.news.CreateNewsBulk"
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	ids, err := r.nextNewsIDs(tx, len(createForms))
+	if err != nil {
+		return nil, err
+	}
+
+	newsRows := make([][]interface{}, len(createForms))
+	categoryRows := make([][]interface{}, 0)
+	mediaRows := make([][]interface{}, 0)
+	for i, form := range createForms {
+		newsRows[i] = []interface{}{ids[i], form.Title, form.Content, contentFormat(form.ContentFormat), models.NewsStatusDraft, form.PublishAt, form.ExpiresAt}
+
+		if form.Categories != nil {
+			seen := make(map[int64]bool, len(*form.Categories))
+			for _, categoryID := range *form.Categories {
+				if !seen[categoryID] {
+					seen[categoryID] = true
+					categoryRows = append(categoryRows, []interface{}{ids[i], categoryID})
+				}
+			}
+		}
+
+		if form.Media != nil {
+			mediaRows = append(mediaRows, newsMediaRows(ids[i], *form.Media)...)
+		}
+	}
+
+	if err = r.insertRows(tx, op, SqlInsertNewsBulk, newsRows); err != nil {
+		return nil, fmt.Errorf("failed to insert news: %w", err)
+	}
+
+	if err = r.insertRows(tx, op, SqlInsertNewsCategoriesBulk, categoryRows); err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, apperrors.NewValidation("Categories: unknown category IDs")
+		}
+		return nil, fmt.Errorf("failed to insert news categories: %w", err)
+	}
+
+	if err = r.insertRows(tx, op, SqlInsertNewsMediaBulk, mediaRows); err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, apperrors.NewValidation("Media: unknown media IDs")
+		}
+		return nil, fmt.Errorf("failed to insert news media: %w", err)
+	}
+
+	if _, err = tx.ExecContext(r.ctx, SqlInsertNewsRevisions, pq.Array(ids), editor); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to insert revisions")
+		return nil, fmt.Errorf("failed to insert revisions: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"created":   len(ids),
+	}).Info("News created in bulk")
+
+	return ids, nil
+}
+
+func (r *NewsRepository) UpdateNews(newsId int64, updateFields map[string]interface{}, categories, media *[]int64, expectedVersion *int64, editor string) error {
 	const op = "repository.news.UpdateNews"
 
 	tx, err := r.db.Begin()
@@ -138,29 +430,54 @@ func (r *NewsRepository) UpdateNews(newsId int64, updateFields map[string]interf
 		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	defer r.rollbackOnError(tx, op)
+	defer rollbackOnError(r.log, tx, op)
 
 	news, err := r.findNewsByID(tx, newsId)
 	if err != nil {
 		return err
 	}
 
-	if len(updateFields) > 0 {
-		if title, ok := updateFields["title"]; ok {
-			news.Title = title.(string)
-		}
+	if expectedVersion != nil && news.Version != *expectedVersion {
+		r.log.WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+			"expected":  *expectedVersion,
+			"current":   news.Version,
+		}).Warn("Stale news version")
+		return apperrors.NewVersionConflict(news.Version)
+	}
 
-		if content, ok := updateFields["content"]; ok {
-			news.Content = content.(string)
-		}
+	if title, ok := updateFields["title"]; ok {
+		news.Title = title.(string)
+	}
 
-		if err = tx.Update(news); err != nil {
-			r.log.WithError(err).WithFields(logrus.Fields{
-				"operation": op,
-				"news_id":   newsId,
-			}).Error("Failed to update news")
-			return fmt.Errorf("failed to update news: %w", err)
-		}
+	if content, ok := updateFields["content"]; ok {
+		news.Content = content.(string)
+	}
+
+	if contentFormat, ok := updateFields["content_format"]; ok {
+		news.ContentFormat = contentFormat.(string)
+	}
+
+	if publishAt, ok := updateFields["publish_at"]; ok {
+		news.PublishAt = publishAt.(*time.Time)
+	}
+
+	if expiresAt, ok := updateFields["expires_at"]; ok {
+		news.ExpiresAt = expiresAt.(*time.Time)
+	}
+
+	if news.PublishAt != nil && news.ExpiresAt != nil && !news.ExpiresAt.After(*news.PublishAt) {
+		return apperrors.NewValidation("ExpiresAt: must be after PublishAt")
+	}
+
+	// The row is updated even when only categories or media change, so the version is bumped by the trigger.
+	if err = tx.Update(news); err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Error("Failed to update news")
+		return fmt.Errorf("failed to update news: %w", err)
 	}
 
 	if categories != nil {
@@ -169,6 +486,16 @@ func (r *NewsRepository) UpdateNews(newsId int64, updateFields map[string]interf
 		}
 	}
 
+	if media != nil {
+		if err = r.updateMedia(tx, newsId, *media); err != nil {
+			return err
+		}
+	}
+
+	if err = r.insertRevision(tx, newsId, editor); err != nil {
+		return err
+	}
+
 	if err = tx.Commit(); err != nil {
 		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
 		return fmt.Errorf("failed to commit transaction: %w", err)
@@ -182,10 +509,520 @@ func (r *NewsRepository) UpdateNews(newsId int64, updateFields map[string]interf
 	return nil
 }
 
+func (r *NewsRepository) UpdateNewsStatus(newsId int64, allowedFrom []string, status string) error {
+	const op = "repository.news.UpdateNewsStatus"
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	news, err := r.findNewsByID(tx, newsId)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(allowedFrom, news.Status) {
+		r.log.WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+			"from":      news.Status,
+			"to":        status,
+		}).Warn("Status transition not allowed")
+		return apperrors.NewConflict(fmt.Sprintf("Cannot change status from %s to %s", news.Status, status))
+	}
+
+	news.Status = status
+	if err = tx.Update(news); err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Error("Failed to update news status")
+		return fmt.Errorf("failed to update news status: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"news_id":   newsId,
+		"status":    status,
+	}).Info("News status updated successfully")
+
+	return nil
+}
+
+// BulkEditNews applies editForm to the news in editForm.Ids, or to the news matching filter when there are none,
+// in one transaction, and returns the number of news it changed. set_status skips news whose status is not in allowedFrom.
+// Category changes bump the version of the news and are recorded as revisions.
+func (r *NewsRepository) BulkEditNews(editForm models.NewsBulkEditForm, filter models.NewsFilter, allowedFrom []string) (int64, error) {
+	const op = "repository.news.BulkEditNews"
+
+	var query string
+	var args []interface{}
+	switch editForm.Operation {
+	case models.BulkAddCategories:
+		query, args = SqlBulkAddNewsCategories, []interface{}{pq.Array(editForm.Categories)}
+	case models.BulkRemoveCategories:
+		query, args = SqlBulkRemoveNewsCategories, []interface{}{pq.Array(editForm.Categories)}
+	case models.BulkReplaceCategory:
+		query, args = SqlBulkReplaceNewsCategory, []interface{}{editForm.From, editForm.To}
+	case models.BulkSetStatus:
+		query, args = SqlBulkSetNewsStatus, []interface{}{editForm.Status, pq.Array(allowedFrom)}
+	default:
+		return 0, fmt.Errorf("unsupported bulk operation %q", editForm.Operation)
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	targetArgs := queryArgs{}
+	where := newsConditions(filter, &targetArgs)
+	if len(editForm.Ids) > 0 {
+		where += fmt.Sprintf("\n  AND n.id = ANY (%s::BIGINT[])", targetArgs.add(pq.Array(editForm.Ids)))
+	}
+
+	targets, err := r.queryIDs(tx, op, fmt.Sprintf(SqlSelectBulkNewsIDs, where), targetArgs...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select news to edit: %w", err)
+	}
+
+	if len(targets) == 0 {
+		return 0, nil
+	}
+
+	changed, err := r.queryIDs(tx, op, query, append([]interface{}{pq.Array(targets)}, args...)...)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, apperrors.NewValidation("Categories: unknown category IDs")
+		}
+		return 0, fmt.Errorf("failed to edit news: %w", err)
+	}
+
+	if editForm.Operation != models.BulkSetStatus && len(changed) > 0 {
+		// Updating the rows fires the triggers that bump the version and updated_at.
+		if _, err = tx.ExecContext(r.ctx, SqlTouchNews, pq.Array(changed)); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to touch news")
+			return 0, fmt.Errorf("failed to touch news: %w", err)
+		}
+
+		if _, err = tx.ExecContext(r.ctx, SqlInsertNewsRevisions, pq.Array(changed), editForm.Editor); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to insert revisions")
+			return 0, fmt.Errorf("failed to insert revisions: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"bulk":      editForm.Operation,
+		"targets":   len(targets),
+		"changed":   len(changed),
+	}).Info("News edited in bulk")
+
+	return int64(len(changed)), nil
+}
+
+// AddNewsCategories attaches categories to news without touching its other categories and returns the updated news.
+// Categories the news already has are skipped, so repeating the call changes nothing.
+func (r *NewsRepository) AddNewsCategories(newsId int64, categoryIDs []int64, editor string) (models.NewsWithCategories, error) {
+	return r.changeNewsCategories("repository.news.AddNewsCategories", newsId, SqlBulkAddNewsCategories, categoryIDs, editor)
+}
+
+// RemoveNewsCategory detaches a category from news and returns the updated news. Removing a category
+// the news does not have changes nothing.
+func (r *NewsRepository) RemoveNewsCategory(newsId, categoryId int64, editor string) (models.NewsWithCategories, error) {
+	return r.changeNewsCategories("repository.news.RemoveNewsCategory", newsId, SqlBulkRemoveNewsCategories, []int64{categoryId}, editor)
+}
+
+// changeNewsCategories runs query, one of the bulk category statements, on a single news. The version is bumped
+// and a revision recorded only when the categories actually changed.
+func (r *NewsRepository) changeNewsCategories(op string, newsId int64, query string, categoryIDs []int64, editor string) (models.NewsWithCategories, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return models.NewsWithCategories{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	if _, err = r.findNewsByID(tx, newsId); err != nil {
+		return models.NewsWithCategories{}, err
+	}
+
+	changed, err := r.queryIDs(tx, op, query, pq.Array([]int64{newsId}), pq.Array(categoryIDs))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return models.NewsWithCategories{}, apperrors.NewValidation("Categories: unknown category IDs")
+		}
+		return models.NewsWithCategories{}, fmt.Errorf("failed to change news categories: %w", err)
+	}
+
+	if len(changed) > 0 {
+		if _, err = tx.ExecContext(r.ctx, SqlTouchNews, pq.Array(changed)); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to touch news")
+			return models.NewsWithCategories{}, fmt.Errorf("failed to touch news: %w", err)
+		}
+
+		if err = r.insertRevision(tx, newsId, editor); err != nil {
+			return models.NewsWithCategories{}, err
+		}
+	}
+
+	news, err := scanNewsWithCategories(tx.QueryRowContext(r.ctx, SqlSelectNewsByID, newsId))
+	if err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Error("Failed to select news")
+		return models.NewsWithCategories{}, fmt.Errorf("failed to select news: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return models.NewsWithCategories{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"news_id":   newsId,
+		"changed":   len(changed) > 0,
+	}).Info("News categories changed")
+
+	return news, nil
+}
+
+func (r *NewsRepository) RunScheduledTransitions(now time.Time) (models.ScheduleResult, error) {
+	const op = "repository.news.RunScheduledTransitions"
+
+	var result models.ScheduleResult
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return result, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	if err = tx.QueryRowContext(r.ctx, SqlTryAdvisoryXactLock, schedulerLockKey).Scan(&result.Locked); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to acquire scheduler lock")
+		return result, fmt.Errorf("failed to acquire scheduler lock: %w", err)
+	}
+
+	if !result.Locked {
+		return result, nil
+	}
+
+	// The timestamps trigger dates scheduled publications by their PublishAt only while this is set, manual publishing keeps NOW().
+	if _, err = tx.ExecContext(r.ctx, SqlSetScheduledAt, now.Format(time.RFC3339Nano)); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to set scheduled time")
+		return result, fmt.Errorf("failed to set scheduled time: %w", err)
+	}
+
+	published, err := tx.ExecContext(r.ctx, SqlPublishScheduledNews, now)
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to publish scheduled news")
+		return result, fmt.Errorf("failed to publish scheduled news: %w", err)
+	}
+	if result.Published, err = published.RowsAffected(); err != nil {
+		return result, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	archived, err := tx.ExecContext(r.ctx, SqlArchiveExpiredNews, now)
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to archive expired news")
+		return result, fmt.Errorf("failed to archive expired news: %w", err)
+	}
+	if result.Archived, err = archived.RowsAffected(); err != nil {
+		return result, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return result, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *NewsRepository) DeleteNews(newsId int64) error {
+	const op = "repository.news.DeleteNews"
+
+	result, err := r.db.ExecContext(r.ctx, SqlSoftDeleteNews, newsId)
+	if err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Error("Failed to soft delete news")
+		return fmt.Errorf("failed to soft delete news: %w", err)
+	}
+
+	if err = r.ensureAffected(result, op, newsId, "News not found"); err != nil {
+		return err
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"news_id":   newsId,
+	}).Info("News moved to trash")
+
+	return nil
+}
+
+func (r *NewsRepository) RestoreNews(newsId int64) error {
+	const op = "repository.news.RestoreNews"
+
+	result, err := r.db.ExecContext(r.ctx, SqlRestoreNews, newsId)
+	if err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Error("Failed to restore news")
+		return fmt.Errorf("failed to restore news: %w", err)
+	}
+
+	if err = r.ensureAffected(result, op, newsId, "News not found in trash"); err != nil {
+		return err
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"news_id":   newsId,
+	}).Info("News restored from trash")
+
+	return nil
+}
+
+func (r *NewsRepository) PurgeNews(deletedBefore time.Time) (int64, error) {
+	const op = "repository.news.PurgeNews"
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	rows, err := tx.QueryContext(r.ctx, SqlSelectPurgeableNewsIDs, deletedBefore)
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to select news to purge")
+		return 0, fmt.Errorf("failed to select news to purge: %w", err)
+	}
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan news id")
+			return 0, fmt.Errorf("failed to scan news id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating news ids")
+		return 0, fmt.Errorf("error iterating news ids: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if _, err = tx.ExecContext(r.ctx, SqlDeleteCategoriesByNewsIDs, pq.Array(ids)); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to delete categories of purged news")
+		return 0, fmt.Errorf("failed to delete categories of purged news: %w", err)
+	}
+
+	result, err := tx.ExecContext(r.ctx, SqlDeleteNewsByIDs, pq.Array(ids))
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to delete purged news")
+		return 0, fmt.Errorf("failed to delete purged news: %w", err)
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"purged":    purged,
+	}).Info("Trash purged")
+
+	return purged, nil
+}
+
+func (r *NewsRepository) selectNewsList(op, query string, args ...interface{}) ([]models.NewsWithCategories, error) {
+	rows, err := r.db.QueryContext(r.ctx, query, args...)
+	if err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"args":      args,
+		}).Error("Failed to select news")
+		return nil, fmt.Errorf("failed to select news: %w", err)
+	}
+	defer rows.Close()
+
+	newsList := make([]models.NewsWithCategories, 0)
+	for rows.Next() {
+		n, err := scanNewsWithCategories(rows)
+		if err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan news row")
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		newsList = append(newsList, n)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating news rows")
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return newsList, nil
+}
+
+func (r *NewsRepository) nextNewsIDs(tx *reform.TX, count int) ([]int64, error) {
+	const op = "repository.news.nextNewsIDs"
+
+	ids, err := r.queryIDs(tx, op, SqlSelectNextNewsIDs, count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate news ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// queryIDs runs query inside tx and collects the single BIGINT column it returns.
+func (r *NewsRepository) queryIDs(tx *reform.TX, op, query string, args ...interface{}) ([]int64, error) {
+	rows, err := tx.QueryContext(r.ctx, query, args...)
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to select news ids")
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan news id")
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating news ids")
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// insertRows runs query, an INSERT with a %s in place of VALUES rows, in batches of bulkInsertRows rows.
+func (r *NewsRepository) insertRows(tx *reform.TX, op, query string, rows [][]interface{}) error {
+	for batch := range slices.Chunk(rows, bulkInsertRows) {
+		args := make(queryArgs, 0, len(batch)*len(batch[0]))
+		values := make([]string, len(batch))
+		for i, row := range batch {
+			placeholders := make([]string, len(row))
+			for j, value := range row {
+				placeholders[j] = args.add(value)
+			}
+			values[i] = "(" + strings.Join(placeholders, ", ") + ")"
+		}
+
+		if _, err := tx.ExecContext(r.ctx, fmt.Sprintf(query, strings.Join(values, ",\n       ")), args...); err != nil {
+			r.log.WithError(err).WithFields(logrus.Fields{
+				"operation": op,
+				"rows":      len(batch),
+			}).Error("Failed to insert rows")
+			return err
+		}
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanNewsWithCategories(row rowScanner) (models.NewsWithCategories, error) {
+	var n models.NewsWithCategories
+	var categories, media []int64
+
+	if err := row.Scan(newsScanDest(&n, &categories, &media)...); err != nil {
+		return models.NewsWithCategories{}, err
+	}
+
+	n.Categories = nonNilIDs(categories)
+	n.Media = nonNilIDs(media)
+
+	return n, nil
+}
+
+// newsScanDest lists scan targets in the column order shared by all news list queries.
+func newsScanDest(n *models.NewsWithCategories, categories, media *[]int64) []interface{} {
+	return []interface{}{&n.ID, &n.Title, &n.Content, &n.ContentFormat, &n.Status, &n.PublishAt, &n.ExpiresAt,
+		&n.CreatedAt, &n.UpdatedAt, &n.PublishedAt, &n.DeletedAt, &n.Version, pq.Array(categories), pq.Array(media)}
+}
+
+// contentFormat stores news created without a content format as plain text.
+func contentFormat(format string) string {
+	return cmp.Or(format, models.ContentFormatPlain)
+}
+
+func nonNilIDs(ids []int64) []int64 {
+	if ids == nil {
+		return []int64{}
+	}
+	return ids
+}
+
+func (r *NewsRepository) ensureAffected(result sql.Result, op string, newsId int64, notFoundMessage string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if affected == 0 {
+		r.log.WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Warn(notFoundMessage)
+		return apperrors.NewNotFound(notFoundMessage)
+	}
+
+	return nil
+}
+
+// findNewsByID locks the news row until the end of the transaction.
 func (r *NewsRepository) findNewsByID(tx *reform.TX, newsId int64) (*models.News, error) {
 	const op = "repository.news.findNewsByID"
 
-	record, err := tx.FindByPrimaryKeyFrom(models.NewsTable, newsId)
+	news := new(models.News)
+	err := tx.SelectOneTo(news, "WHERE id = $1 FOR UPDATE", newsId)
 	if err != nil {
 		if errors.Is(err, reform.ErrNoRows) {
 			r.log.WithFields(logrus.Fields{
@@ -201,25 +1038,26 @@ func (r *NewsRepository) findNewsByID(tx *reform.TX, newsId int64) (*models.News
 		return nil, fmt.Errorf("failed to find news: %w", err)
 	}
 
-	return record.(*models.News), nil
-}
-
-func (r *NewsRepository) rollbackOnError(tx *reform.TX, op string) {
-	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
-		r.log.WithError(err).WithField("operation", op).Error("Failed to rollback transaction")
+	if news.DeletedAt != nil {
+		r.log.WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Warn("News is in trash")
+		return nil, apperrors.NewNotFound("News not found")
 	}
+
+	return news, nil
 }
 
+// insertCategories attaches categories to news, skipping the ones it already has.
 func (r *NewsRepository) insertCategories(tx *reform.TX, newsId int64, categoryIDs []int64) error {
 	const op = "repository.news.insertCategories"
 
 	for _, categoryID := range categoryIDs {
-		newsCategory := &models.NewsCategory{
-			NewsId:     newsId,
-			CategoryId: categoryID,
-		}
-
-		if err := tx.Save(newsCategory); err != nil {
+		if _, err := tx.ExecContext(r.ctx, SqlInsertNewsCategories, newsId, categoryID); err != nil {
+			if isForeignKeyViolation(err) {
+				return apperrors.NewValidation(fmt.Sprintf("Categories: unknown category IDs: %d", categoryID))
+			}
 			r.log.WithError(err).WithFields(logrus.Fields{
 				"operation":   op,
 				"news_id":     newsId,
@@ -249,24 +1087,47 @@ func (r *NewsRepository) updateCategories(tx *reform.TX, newsId int64, categoryI
 		return fmt.Errorf("failed to delete old categories: %w", err)
 	}
 
-	if len(categoryIDs) > 0 {
-		for _, categoryID := range categoryIDs {
-			if _, err := tx.ExecContext(r.ctx, SqlInsertNewsCategories, newsId, categoryID); err != nil {
-				r.log.WithError(err).WithFields(logrus.Fields{
-					"operation":   op,
-					"news_id":     newsId,
-					"category_id": categoryID,
-				}).Error("Failed to insert category")
-				return fmt.Errorf("failed to insert category %d: %w", categoryID, err)
-			}
-		}
+	return r.insertCategories(tx, newsId, categoryIDs)
+}
 
-		r.log.WithFields(logrus.Fields{
-			"operation":  op,
-			"news_id":    newsId,
-			"categories": len(categoryIDs),
-		}).Debug("Categories updated")
+// insertMedia attaches media to news, numbering their positions in the given order.
+func (r *NewsRepository) insertMedia(tx *reform.TX, newsId int64, mediaIDs []int64) error {
+	const op = "repository.news.insertMedia"
+
+	if err := r.insertRows(tx, op, SqlInsertNewsMediaBulk, newsMediaRows(newsId, mediaIDs)); err != nil {
+		if isForeignKeyViolation(err) {
+			return apperrors.NewValidation("Media: unknown media IDs")
+		}
+		return fmt.Errorf("failed to insert news media: %w", err)
 	}
 
 	return nil
 }
+
+func (r *NewsRepository) updateMedia(tx *reform.TX, newsId int64, mediaIDs []int64) error {
+	const op = "repository.news.updateMedia"
+
+	if _, err := tx.ExecContext(r.ctx, SqlDeleteNewsMedia, newsId); err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Error("Failed to delete old media")
+		return fmt.Errorf("failed to delete old media: %w", err)
+	}
+
+	return r.insertMedia(tx, newsId, mediaIDs)
+}
+
+// newsMediaRows are the news_media rows attaching media to news, skipping repeated IDs.
+func newsMediaRows(newsId int64, mediaIDs []int64) [][]interface{} {
+	rows := make([][]interface{}, 0, len(mediaIDs))
+	seen := make(map[int64]bool, len(mediaIDs))
+	for _, mediaID := range mediaIDs {
+		if !seen[mediaID] {
+			seen[mediaID] = true
+			rows = append(rows, []interface{}{newsId, mediaID, len(rows) + 1})
+		}
+	}
+
+	return rows
+}
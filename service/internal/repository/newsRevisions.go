@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"service/internal/apperrors"
+	"service/internal/models"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/reform.v1"
+)
+
+var (
+	//go:embed sql/insert_news_revision.sql
+	SqlInsertNewsRevision string
+	//go:embed sql/insert_news_revisions.sql
+	SqlInsertNewsRevisions string
+	//go:embed sql/select_news_revisions.sql
+	SqlSelectNewsRevisions string
+	//go:embed sql/select_news_revision.sql
+	SqlSelectNewsRevision string
+	//go:embed sql/select_news_exists.sql
+	SqlSelectNewsExists string
+)
+
+func (r *NewsRepository) GetRevisions(newsId int64) ([]models.NewsRevision, error) {
+	const op = "repository.news.GetRevisions"
+
+	rows, err := r.db.QueryContext(r.ctx, SqlSelectNewsRevisions, newsId)
+	if err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Error("Failed to select revisions")
+		return nil, fmt.Errorf("failed to select revisions: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := make([]models.NewsRevision, 0)
+	for rows.Next() {
+		revision, err := scanNewsRevision(rows)
+		if err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan revision row")
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating revision rows")
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	if len(revisions) == 0 {
+		var exists bool
+		if err = r.db.QueryRowContext(r.ctx, SqlSelectNewsExists, newsId).Scan(&exists); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to check news existence")
+			return nil, fmt.Errorf("failed to check news existence: %w", err)
+		}
+		if !exists {
+			return nil, apperrors.NewNotFound("News not found")
+		}
+	}
+
+	return revisions, nil
+}
+
+func (r *NewsRepository) GetRevision(newsId, revision int64) (models.NewsRevision, error) {
+	const op = "repository.news.GetRevision"
+
+	rev, err := scanNewsRevision(r.db.QueryRowContext(r.ctx, SqlSelectNewsRevision, newsId, revision))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.log.WithFields(logrus.Fields{
+				"operation": op,
+				"news_id":   newsId,
+				"revision":  revision,
+			}).Warn("Revision not found")
+			return models.NewsRevision{}, apperrors.NewRevisionNotFound("Revision not found")
+		}
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+			"revision":  revision,
+		}).Error("Failed to select revision")
+		return models.NewsRevision{}, fmt.Errorf("failed to select revision: %w", err)
+	}
+
+	return rev, nil
+}
+
+// insertRevision snapshots the current state of the news inside tx, so it must run after all changes are written.
+func (r *NewsRepository) insertRevision(tx *reform.TX, newsId int64, editor string) error {
+	const op = "repository.news.insertRevision"
+
+	var revision int64
+	if err := tx.QueryRowContext(r.ctx, SqlInsertNewsRevision, newsId, editor).Scan(&revision); err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+		}).Error("Failed to insert revision")
+		return fmt.Errorf("failed to insert revision: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"news_id":   newsId,
+		"revision":  revision,
+	}).Debug("Revision recorded")
+
+	return nil
+}
+
+func scanNewsRevision(row rowScanner) (models.NewsRevision, error) {
+	var rev models.NewsRevision
+	var categories []int64
+
+	err := row.Scan(&rev.ID, &rev.NewsId, &rev.Revision, &rev.Title, &rev.Content,
+		pq.Array(&categories), &rev.Editor, &rev.CreatedAt)
+	if err != nil {
+		return models.NewsRevision{}, err
+	}
+
+	rev.Categories = nonNilIDs(categories)
+
+	return rev, nil
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"service/internal/models"
+	customLog "service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/pressly/goose/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
+	"gopkg.in/reform.v1/dialects/postgresql"
+)
+
+// testDB connects to the database from TEST_DATABASE_URL and migrates it, the test is skipped without it.
+func testDB(t *testing.T) *reform.DB {
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", url)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	require.NoError(t, goose.Up(db, "../../migrations"))
+
+	return reform.NewDB(db, postgresql.Dialect, nil)
+}
+
+func TestPublishedAt(t *testing.T) {
+	db := testDB(t)
+
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+	repo := NewNewsRepository(db, &customLog.Logger{Logger: log}, context.Background())
+
+	createReview := func(publishAt time.Time) int64 {
+		var id int64
+		err := db.QueryRow(
+			"INSERT INTO news (title, content, status, publish_at) VALUES ('Scheduled', 'Content', 'review', $1) RETURNING id",
+			publishAt,
+		).Scan(&id)
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Exec("DELETE FROM news WHERE id = $1", id) })
+		return id
+	}
+
+	t.Run("ManualPublishWithPastPublishAt", func(t *testing.T) {
+		newsId := createReview(time.Now().Add(-72 * time.Hour))
+
+		var before time.Time
+		require.NoError(t, db.QueryRow("SELECT clock_timestamp()").Scan(&before))
+
+		require.NoError(t, repo.UpdateNewsStatus(newsId, []string{models.NewsStatusReview}, models.NewsStatusPublished))
+
+		news, err := repo.GetNewsByID(newsId)
+		require.NoError(t, err)
+		require.NotNil(t, news.PublishedAt)
+		assert.False(t, news.PublishedAt.Before(before))
+	})
+
+	t.Run("ScheduledPublish", func(t *testing.T) {
+		publishAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+		newsId := createReview(publishAt)
+
+		result, err := repo.RunScheduledTransitions(publishAt.Add(time.Minute))
+		require.NoError(t, err)
+		require.True(t, result.Locked)
+
+		news, err := repo.GetNewsByID(newsId)
+		require.NoError(t, err)
+		assert.Equal(t, models.NewsStatusPublished, news.Status)
+		require.NotNil(t, news.PublishedAt)
+		assert.True(t, publishAt.Equal(*news.PublishedAt))
+	})
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	_ "embed"
+	"fmt"
+	"service/internal/models"
+	"time"
+)
+
+var (
+	//go:embed sql/select_sitemap_chunks.sql
+	SqlSelectSitemapChunks string
+	//go:embed sql/select_sitemap_entries.sql
+	SqlSelectSitemapEntries string
+	//go:embed sql/select_recently_published_news.sql
+	SqlSelectRecentlyPublishedNews string
+)
+
+// GetSitemapChunks returns the sitemap files that hold published news when it is split by ID ranges of chunkSize.
+func (r *NewsRepository) GetSitemapChunks(chunkSize int64) ([]models.SitemapChunk, error) {
+	const op = "repository.news.GetSitemapChunks"
+
+	rows, err := r.db.QueryContext(r.ctx, SqlSelectSitemapChunks, chunkSize)
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to select sitemap chunks")
+		return nil, fmt.Errorf("failed to select sitemap chunks: %w", err)
+	}
+	defer rows.Close()
+
+	chunks := make([]models.SitemapChunk, 0)
+	for rows.Next() {
+		var chunk models.SitemapChunk
+		if err = rows.Scan(&chunk.Number, &chunk.LastMod); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan sitemap chunk row")
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating sitemap chunk rows")
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return chunks, nil
+}
+
+// GetSitemapEntries returns the published news of sitemap file number chunk, in ID order.
+func (r *NewsRepository) GetSitemapEntries(chunk, chunkSize int64) ([]models.SitemapEntry, error) {
+	return r.selectSitemapEntries("repository.news.GetSitemapEntries", SqlSelectSitemapEntries,
+		(chunk-1)*chunkSize, chunk*chunkSize)
+}
+
+// GetRecentlyPublished returns at most limit news published since the given time, the latest first.
+func (r *NewsRepository) GetRecentlyPublished(since time.Time, limit int64) ([]models.SitemapEntry, error) {
+	return r.selectSitemapEntries("repository.news.GetRecentlyPublished", SqlSelectRecentlyPublishedNews, since, limit)
+}
+
+func (r *NewsRepository) selectSitemapEntries(op, query string, args ...interface{}) ([]models.SitemapEntry, error) {
+	rows, err := r.db.QueryContext(r.ctx, query, args...)
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to select sitemap entries")
+		return nil, fmt.Errorf("failed to select sitemap entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]models.SitemapEntry, 0)
+	for rows.Next() {
+		var entry models.SitemapEntry
+		if err = rows.Scan(&entry.ID, &entry.Title, &entry.UpdatedAt, &entry.PublishedAt); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan sitemap entry row")
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating sitemap entry rows")
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return entries, nil
+}
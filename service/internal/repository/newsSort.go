@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"service/internal/models"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// newsSortColumns is the only source of column names that end up in ORDER BY,
+// so sort fields coming from clients are never interpolated into SQL.
+var newsSortColumns = map[string]string{
+	models.NewsSortID:          "n.id",
+	models.NewsSortTitle:       "n.title",
+	models.NewsSortCreatedAt:   "n.created_at",
+	models.NewsSortUpdatedAt:   "n.updated_at",
+	models.NewsSortPublishedAt: "n.published_at",
+}
+
+// searchSortColumns adds the rank expression of search_news.sql, where the search query is aliased as q.
+var searchSortColumns = func() map[string]string {
+	columns := maps.Clone(newsSortColumns)
+	columns[models.NewsSortRelevance] = "ts_rank(n.search_vector, q.query)"
+	return columns
+}()
+
+// newsNullableSortColumns need NULL-aware keyset conditions.
+var newsNullableSortColumns = map[string]bool{
+	"n.published_at": true,
+}
+
+// newsOrderBy renders the sort with NULLs last. A backward order is the exact reverse,
+// used to read the page before a cursor.
+func newsOrderBy(sort models.NewsSort, backward bool) (string, error) {
+	if len(sort) == 0 {
+		sort = models.DefaultNewsSort
+	}
+
+	return orderBy(newsSortColumns, sort, backward)
+}
+
+func searchOrderBy(sort models.NewsSort) (string, error) {
+	if len(sort) == 0 {
+		sort = models.DefaultSearchSort
+	}
+
+	return orderBy(searchSortColumns, sort, false)
+}
+
+func orderBy(columns map[string]string, sort models.NewsSort, backward bool) (string, error) {
+	parts := make([]string, 0, len(sort)+1)
+	for _, key := range sort {
+		column, ok := columns[key.Field]
+		if !ok {
+			return "", fmt.Errorf("unsupported sort field %q", key.Field)
+		}
+
+		nulls := "NULLS LAST"
+		if backward {
+			nulls = "NULLS FIRST"
+		}
+		parts = append(parts, column+" "+sortDirection(key.Desc != backward)+" "+nulls)
+	}
+
+	if !hasIDKey(sort) {
+		parts = append(parts, "n.id "+sortDirection(sort[len(sort)-1].Desc != backward))
+	}
+
+	return strings.Join(parts, ", "), nil
+}
+
+// newsKeyset selects news strictly after the cursor in the sort order, or strictly before it for a backward cursor.
+// Each key is compared only when all previous keys are equal, with the ID as the final tie-breaker.
+func newsKeyset(sort models.NewsSort, cursor models.NewsCursor, args *queryArgs) (string, error) {
+	if len(sort) == 0 {
+		sort = models.DefaultNewsSort
+	}
+
+	if len(cursor.Values) != len(sort) {
+		return "", errors.New("cursor does not match sort order")
+	}
+
+	keys := slices.Clone(sort)
+	values := slices.Clone(cursor.Values)
+	if !hasIDKey(sort) {
+		id := strconv.FormatInt(cursor.ID, 10)
+		keys = append(keys, models.SortKey{Field: models.NewsSortID, Desc: sort[len(sort)-1].Desc})
+		values = append(values, &id)
+	}
+
+	equal := make([]string, 0, len(keys))
+	disjuncts := make([]string, 0, len(keys))
+	for i, key := range keys {
+		column, ok := newsSortColumns[key.Field]
+		if !ok {
+			return "", fmt.Errorf("unsupported sort field %q", key.Field)
+		}
+
+		placeholder := ""
+		if values[i] != nil {
+			placeholder = args.add(*values[i])
+		}
+
+		if beyond := keysetBeyond(column, key.Desc, cursor.Backward, placeholder); beyond != "" {
+			disjuncts = append(disjuncts, "("+strings.Join(append(slices.Clone(equal), beyond), " AND ")+")")
+		}
+
+		if placeholder == "" {
+			equal = append(equal, column+" IS NULL")
+		} else {
+			equal = append(equal, column+" = "+placeholder)
+		}
+	}
+
+	if len(disjuncts) == 0 {
+		return "FALSE", nil
+	}
+
+	return "(" + strings.Join(disjuncts, "\n       OR ") + ")", nil
+}
+
+// keysetBeyond compares column with the cursor value in placeholder, empty for NULL.
+// NULLs sort last, so nothing follows a NULL and everything but NULL precedes it.
+func keysetBeyond(column string, desc, backward bool, placeholder string) string {
+	if placeholder == "" {
+		if backward {
+			return column + " IS NOT NULL"
+		}
+		return ""
+	}
+
+	operator := ">"
+	if desc != backward {
+		operator = "<"
+	}
+
+	condition := column + " " + operator + " " + placeholder
+	if newsNullableSortColumns[column] && !backward {
+		condition = "(" + condition + " OR " + column + " IS NULL)"
+	}
+
+	return condition
+}
+
+func hasIDKey(sort models.NewsSort) bool {
+	return slices.ContainsFunc(sort, func(key models.SortKey) bool {
+		return key.Field == models.NewsSortID
+	})
+}
+
+func sortDirection(desc bool) string {
+	if desc {
+		return "DESC"
+	}
+	return "ASC"
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"service/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewsOrderBy(t *testing.T) {
+	testData := []struct {
+		name     string
+		sort     models.NewsSort
+		expected string
+	}{
+		{
+			name:     "default",
+			sort:     nil,
+			expected: "n.id DESC NULLS LAST",
+		},
+		{
+			name:     "published_at descending",
+			sort:     models.NewsSort{{Field: models.NewsSortPublishedAt, Desc: true}},
+			expected: "n.published_at DESC NULLS LAST, n.id DESC",
+		},
+		{
+			name:     "created_at ascending",
+			sort:     models.NewsSort{{Field: models.NewsSortCreatedAt}},
+			expected: "n.created_at ASC NULLS LAST, n.id ASC",
+		},
+		{
+			name:     "multiple keys",
+			sort:     models.NewsSort{{Field: models.NewsSortPublishedAt, Desc: true}, {Field: models.NewsSortTitle}},
+			expected: "n.published_at DESC NULLS LAST, n.title ASC NULLS LAST, n.id ASC",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run("Success_"+tt.name, func(t *testing.T) {
+			orderBy, err := newsOrderBy(tt.sort, false)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, orderBy)
+		})
+	}
+
+	t.Run("FailedUnknownField", func(t *testing.T) {
+		_, err := newsOrderBy(models.NewsSort{{Field: "title; DROP TABLE news"}}, false)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("FailedRelevanceOutsideSearch", func(t *testing.T) {
+		_, err := newsOrderBy(models.DefaultSearchSort, false)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Success_backward", func(t *testing.T) {
+		orderBy, err := newsOrderBy(models.NewsSort{{Field: models.NewsSortPublishedAt, Desc: true}}, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "n.published_at ASC NULLS FIRST, n.id ASC", orderBy)
+	})
+}
+
+func TestSearchOrderBy(t *testing.T) {
+	t.Run("Success_default", func(t *testing.T) {
+		orderBy, err := searchOrderBy(nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ts_rank(n.search_vector, q.query) DESC NULLS LAST, n.id DESC", orderBy)
+	})
+
+	t.Run("Success_title then relevance", func(t *testing.T) {
+		orderBy, err := searchOrderBy(models.NewsSort{{Field: models.NewsSortTitle}, {Field: models.NewsSortRelevance, Desc: true}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "n.title ASC NULLS LAST, ts_rank(n.search_vector, q.query) DESC NULLS LAST, n.id DESC", orderBy)
+	})
+}
+
+func TestNewsKeyset(t *testing.T) {
+	published := "2025-12-20T10:00:00Z"
+	publishedDesc := models.NewsSort{{Field: models.NewsSortPublishedAt, Desc: true}}
+
+	testData := []struct {
+		name     string
+		sort     models.NewsSort
+		cursor   models.NewsCursor
+		expected string
+		args     queryArgs
+	}{
+		{
+			name:     "default",
+			sort:     models.DefaultNewsSort,
+			cursor:   models.NewsCursor{Values: []*string{ptr("7")}, ID: 7},
+			expected: "((n.id < $3))",
+			args:     queryArgs{10, 0, "7"},
+		},
+		{
+			name:     "default backward",
+			sort:     models.DefaultNewsSort,
+			cursor:   models.NewsCursor{Values: []*string{ptr("7")}, ID: 7, Backward: true},
+			expected: "((n.id > $3))",
+			args:     queryArgs{10, 0, "7"},
+		},
+		{
+			name:   "nullable column",
+			sort:   publishedDesc,
+			cursor: models.NewsCursor{Values: []*string{&published}, ID: 7},
+			expected: "(((n.published_at < $3 OR n.published_at IS NULL))\n" +
+				"       OR (n.published_at = $3 AND n.id < $4))",
+			args: queryArgs{10, 0, published, "7"},
+		},
+		{
+			name:     "null value",
+			sort:     publishedDesc,
+			cursor:   models.NewsCursor{Values: []*string{nil}, ID: 7},
+			expected: "((n.published_at IS NULL AND n.id < $3))",
+			args:     queryArgs{10, 0, "7"},
+		},
+		{
+			name:   "null value backward",
+			sort:   publishedDesc,
+			cursor: models.NewsCursor{Values: []*string{nil}, ID: 7, Backward: true},
+			expected: "((n.published_at IS NOT NULL)\n" +
+				"       OR (n.published_at IS NULL AND n.id > $3))",
+			args: queryArgs{10, 0, "7"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run("Success_"+tt.name, func(t *testing.T) {
+			args := queryArgs{10, 0}
+
+			keyset, err := newsKeyset(tt.sort, tt.cursor, &args)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, keyset)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+
+	t.Run("FailedValuesMismatch", func(t *testing.T) {
+		args := queryArgs{10, 0}
+
+		_, err := newsKeyset(publishedDesc, models.NewsCursor{ID: 7}, &args)
+
+		assert.Error(t, err)
+	})
+}
+
+func ptr(value string) *string {
+	return &value
+}
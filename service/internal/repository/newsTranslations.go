@@ -0,0 +1,225 @@
+package repository
+
+import (
+	_ "embed"
+	"fmt"
+	"service/internal/apperrors"
+	"service/internal/models"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	//go:embed sql/select_news_translations.sql
+	SqlSelectNewsTranslations string
+	//go:embed sql/update_news_translation.sql
+	SqlUpdateNewsTranslation string
+	//go:embed sql/insert_news_translation.sql
+	SqlInsertNewsTranslation string
+	//go:embed sql/delete_news_translation.sql
+	SqlDeleteNewsTranslation string
+	//go:embed sql/count_news_translations.sql
+	SqlCountNewsTranslations string
+)
+
+// GetTranslations returns the translations of news to the given locales, ordered by news ID and locale.
+func (r *NewsRepository) GetTranslations(newsIDs []int64, locales []string) ([]models.NewsTranslation, error) {
+	const op = "repository.news.GetTranslations"
+
+	rows, err := r.db.QueryContext(r.ctx, SqlSelectNewsTranslations, pq.Array(newsIDs), pq.Array(locales))
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to select translations")
+		return nil, fmt.Errorf("failed to select translations: %w", err)
+	}
+	defer rows.Close()
+
+	translations := make([]models.NewsTranslation, 0)
+	for rows.Next() {
+		var translation models.NewsTranslation
+		err = rows.Scan(&translation.NewsId, &translation.Locale, &translation.Title,
+			&translation.Content, &translation.UpdatedAt)
+		if err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan translation row")
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		translations = append(translations, translation)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating translation rows")
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return translations, nil
+}
+
+// SaveTranslation updates the title and content of a translation, nil leaving the current value, or creates
+// the translation when news has none to the locale yet. News is touched so its version is bumped by the trigger.
+func (r *NewsRepository) SaveTranslation(newsId int64, locale string, title, content *string, expectedVersion *int64) error {
+	const op = "repository.news.SaveTranslation"
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	news, err := r.findNewsByID(tx, newsId)
+	if err != nil {
+		return err
+	}
+
+	if expectedVersion != nil && news.Version != *expectedVersion {
+		r.log.WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+			"expected":  *expectedVersion,
+			"current":   news.Version,
+		}).Warn("Stale news version")
+		return apperrors.NewVersionConflict(news.Version)
+	}
+
+	result, err := tx.ExecContext(r.ctx, SqlUpdateNewsTranslation, newsId, locale, title, content)
+	if err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+			"locale":    locale,
+		}).Error("Failed to update translation")
+		return fmt.Errorf("failed to update translation: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if affected == 0 {
+		if title == nil || content == nil {
+			return apperrors.NewValidation(fmt.Sprintf("Title and Content are required to add the %s translation", locale))
+		}
+
+		if _, err = tx.ExecContext(r.ctx, SqlInsertNewsTranslation, newsId, locale, *title, *content); err != nil {
+			r.log.WithError(err).WithFields(logrus.Fields{
+				"operation": op,
+				"news_id":   newsId,
+				"locale":    locale,
+			}).Error("Failed to insert translation")
+			return fmt.Errorf("failed to insert translation: %w", err)
+		}
+	}
+
+	if _, err = tx.ExecContext(r.ctx, SqlTouchNews, pq.Array([]int64{newsId})); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to touch news")
+		return fmt.Errorf("failed to touch news: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"news_id":   newsId,
+		"locale":    locale,
+		"created":   affected == 0,
+	}).Info("Translation saved successfully")
+
+	return nil
+}
+
+func (r *NewsRepository) DeleteTranslation(newsId int64, locale string) error {
+	const op = "repository.news.DeleteTranslation"
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to begin transaction")
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollbackOnError(r.log, tx, op)
+
+	if _, err = r.findNewsByID(tx, newsId); err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(r.ctx, SqlDeleteNewsTranslation, newsId, locale)
+	if err != nil {
+		r.log.WithError(err).WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+			"locale":    locale,
+		}).Error("Failed to delete translation")
+		return fmt.Errorf("failed to delete translation: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if affected == 0 {
+		r.log.WithFields(logrus.Fields{
+			"operation": op,
+			"news_id":   newsId,
+			"locale":    locale,
+		}).Warn("Translation not found")
+		return apperrors.NewTranslationNotFound("Translation not found")
+	}
+
+	if _, err = tx.ExecContext(r.ctx, SqlTouchNews, pq.Array([]int64{newsId})); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to touch news")
+		return fmt.Errorf("failed to touch news: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to commit transaction")
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"operation": op,
+		"news_id":   newsId,
+		"locale":    locale,
+	}).Info("Translation deleted successfully")
+
+	return nil
+}
+
+// CountTranslations counts the news matching filter that are translated, by locale. Locales without
+// translations are left out.
+func (r *NewsRepository) CountTranslations(filter models.NewsFilter, locales []string) (map[string]int64, error) {
+	const op = "repository.news.CountTranslations"
+
+	args := queryArgs{}
+	where := newsConditions(filter, &args) + "\n  AND t.locale = ANY (" + args.add(pq.Array(locales)) + "::TEXT[])"
+
+	rows, err := r.db.QueryContext(r.ctx, fmt.Sprintf(SqlCountNewsTranslations, where), args...)
+	if err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Failed to count translations")
+		return nil, fmt.Errorf("failed to count translations: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int64, len(locales))
+	for rows.Next() {
+		var locale string
+		var count int64
+		if err = rows.Scan(&locale, &count); err != nil {
+			r.log.WithError(err).WithField("operation", op).Error("Failed to scan translation count")
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		counts[locale] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithError(err).WithField("operation", op).Error("Error iterating translation counts")
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,5 @@
+UPDATE news
+SET status = 'archived'
+WHERE status = 'published'
+  AND deleted_at IS NULL
+  AND expires_at <= $1;
\ No newline at end of file
@@ -0,0 +1,10 @@
+WITH added AS (
+    INSERT INTO news_categories (news_id, category_id)
+    SELECT n.id, c.id
+    FROM UNNEST($1::BIGINT[]) AS n(id)
+             CROSS JOIN UNNEST($2::BIGINT[]) AS c(id)
+    ON CONFLICT DO NOTHING
+    RETURNING news_id
+)
+SELECT DISTINCT news_id
+FROM added;
\ No newline at end of file
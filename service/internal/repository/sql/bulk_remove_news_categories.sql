@@ -0,0 +1,8 @@
+WITH removed AS (
+    DELETE FROM news_categories
+    WHERE news_id = ANY ($1::BIGINT[])
+      AND category_id = ANY ($2::BIGINT[])
+    RETURNING news_id
+)
+SELECT DISTINCT news_id
+FROM removed;
\ No newline at end of file
@@ -0,0 +1,13 @@
+WITH removed AS (
+    DELETE FROM news_categories
+    WHERE news_id = ANY ($1::BIGINT[])
+      AND category_id = $2
+    RETURNING news_id
+), added AS (
+    INSERT INTO news_categories (news_id, category_id)
+    SELECT news_id, $3
+    FROM removed
+    ON CONFLICT DO NOTHING
+)
+SELECT news_id
+FROM removed;
\ No newline at end of file
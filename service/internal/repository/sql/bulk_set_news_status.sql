@@ -0,0 +1,5 @@
+UPDATE news
+SET status = $2
+WHERE id = ANY ($1::BIGINT[])
+  AND status = ANY ($3::TEXT[])
+RETURNING id;
\ No newline at end of file
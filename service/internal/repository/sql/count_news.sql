@@ -0,0 +1,3 @@
+SELECT COUNT(*)
+FROM news n
+WHERE %s;
\ No newline at end of file
@@ -0,0 +1,5 @@
+SELECT t.locale, COUNT(*)
+FROM news_translations t
+         JOIN news n ON n.id = t.news_id
+WHERE %s
+GROUP BY t.locale;
\ No newline at end of file
@@ -0,0 +1,22 @@
+DECLARE news_export NO SCROLL CURSOR FOR
+SELECT n.id,
+       n.title,
+       n.content,
+       n.content_format,
+       n.status,
+       n.publish_at,
+       n.expires_at,
+       n.created_at,
+       n.updated_at,
+       n.published_at,
+       n.deleted_at,
+       n.version,
+       COALESCE(ARRAY_AGG(nc.category_id) FILTER (WHERE nc.category_id IS NOT NULL), '{}') AS categories,
+       COALESCE((SELECT ARRAY_AGG(nm.media_id ORDER BY nm.position)
+                 FROM news_media nm
+                 WHERE nm.news_id = n.id), '{}') AS media
+FROM news n
+         LEFT JOIN news_categories nc ON n.id = nc.news_id
+WHERE %s
+GROUP BY n.id
+ORDER BY n.id;
\ No newline at end of file
@@ -0,0 +1 @@
+DELETE FROM news_categories WHERE news_id = ANY($1)
\ No newline at end of file
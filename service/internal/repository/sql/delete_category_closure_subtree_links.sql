@@ -0,0 +1,4 @@
+DELETE
+FROM category_closure
+WHERE descendant_id IN (SELECT descendant_id FROM category_closure WHERE ancestor_id = $1)
+  AND ancestor_id IN (SELECT ancestor_id FROM category_closure WHERE descendant_id = $1 AND ancestor_id != $1);
\ No newline at end of file
@@ -0,0 +1 @@
+DELETE FROM news WHERE id = ANY($1)
\ No newline at end of file
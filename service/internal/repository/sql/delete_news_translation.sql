@@ -0,0 +1,4 @@
+DELETE
+FROM news_translations
+WHERE news_id = $1
+  AND locale = $2;
\ No newline at end of file
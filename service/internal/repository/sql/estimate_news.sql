@@ -0,0 +1,4 @@
+EXPLAIN (FORMAT JSON)
+SELECT n.id
+FROM news n
+WHERE %s;
\ No newline at end of file
@@ -0,0 +1 @@
+FETCH FORWARD 500 FROM news_export;
\ No newline at end of file
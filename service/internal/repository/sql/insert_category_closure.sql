@@ -0,0 +1,6 @@
+INSERT INTO category_closure (ancestor_id, descendant_id, depth)
+SELECT ancestor_id, $1, depth + 1
+FROM category_closure
+WHERE descendant_id = $2
+UNION ALL
+SELECT $1, $1, 0;
\ No newline at end of file
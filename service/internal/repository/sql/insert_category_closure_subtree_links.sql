@@ -0,0 +1,6 @@
+INSERT INTO category_closure (ancestor_id, descendant_id, depth)
+SELECT supertree.ancestor_id, subtree.descendant_id, supertree.depth + subtree.depth + 1
+FROM category_closure supertree
+         CROSS JOIN category_closure subtree
+WHERE supertree.descendant_id = $2
+  AND subtree.ancestor_id = $1;
\ No newline at end of file
@@ -0,0 +1,3 @@
+INSERT INTO media (file_name, content_type, size, width, height, thumbnails)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, created_at
\ No newline at end of file
@@ -0,0 +1,2 @@
+INSERT INTO news (id, title, content, content_format, status, publish_at, expires_at)
+VALUES %s;
\ No newline at end of file
@@ -1 +1 @@
-INSERT INTO news_categories (news_id, category_id) VALUES ($1, $2)
\ No newline at end of file
+INSERT INTO news_categories (news_id, category_id) VALUES ($1, $2) ON CONFLICT DO NOTHING
\ No newline at end of file
@@ -0,0 +1,2 @@
+INSERT INTO news_categories (news_id, category_id)
+VALUES %s;
\ No newline at end of file
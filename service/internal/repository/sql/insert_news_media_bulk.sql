@@ -0,0 +1,2 @@
+INSERT INTO news_media (news_id, media_id, position)
+VALUES %s;
\ No newline at end of file
@@ -0,0 +1,12 @@
+INSERT INTO news_revisions (news_id, revision, title, content, categories, editor)
+SELECT n.id,
+       COALESCE((SELECT MAX(r.revision) FROM news_revisions r WHERE r.news_id = n.id), 0) + 1,
+       n.title,
+       n.content,
+       COALESCE((SELECT ARRAY_AGG(nc.category_id ORDER BY nc.category_id)
+                 FROM news_categories nc
+                 WHERE nc.news_id = n.id), '{}'),
+       NULLIF($2, '')
+FROM news n
+WHERE n.id = $1
+RETURNING revision;
\ No newline at end of file
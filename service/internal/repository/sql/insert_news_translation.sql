@@ -0,0 +1,2 @@
+INSERT INTO news_translations (news_id, locale, title, content)
+VALUES ($1, $2, $3, $4);
\ No newline at end of file
@@ -0,0 +1 @@
+LOCK TABLE category_closure IN SHARE ROW EXCLUSIVE MODE
\ No newline at end of file
@@ -0,0 +1,6 @@
+UPDATE news
+SET status = 'published'
+WHERE status = 'review'
+  AND deleted_at IS NULL
+  AND publish_at <= $1
+  AND (expires_at IS NULL OR expires_at > $1);
\ No newline at end of file
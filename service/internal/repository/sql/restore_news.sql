@@ -0,0 +1 @@
+UPDATE news SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL
\ No newline at end of file
@@ -0,0 +1,35 @@
+WITH q AS (SELECT websearch_to_tsquery('russian', $3) || websearch_to_tsquery('english', $3) AS query),
+     matched AS (SELECT n.*,
+                        ts_rank(n.search_vector, q.query) AS rank,
+                        ROW_NUMBER() OVER (ORDER BY %s) AS position
+                 FROM news n
+                          CROSS JOIN q
+                 WHERE n.search_vector @@ q.query
+                   AND %s
+                 ORDER BY position
+                 LIMIT $1 OFFSET $2)
+SELECT m.id,
+       m.title,
+       m.content,
+       m.content_format,
+       m.status,
+       m.publish_at,
+       m.expires_at,
+       m.created_at,
+       m.updated_at,
+       m.published_at,
+       m.deleted_at,
+       m.version,
+       COALESCE((SELECT ARRAY_AGG(nc.category_id ORDER BY nc.category_id)
+                 FROM news_categories nc
+                 WHERE nc.news_id = m.id), '{}') AS categories,
+       COALESCE((SELECT ARRAY_AGG(nm.media_id ORDER BY nm.position)
+                 FROM news_media nm
+                 WHERE nm.news_id = m.id), '{}') AS media,
+       m.rank,
+       ts_headline('russian', m.title, q.query, 'HighlightAll=true, ' || h.selectors),
+       ts_headline('russian', m.content, q.query, 'MaxFragments=2, MaxWords=30, MinWords=10, ' || h.selectors)
+FROM matched m
+         CROSS JOIN q
+         CROSS JOIN (SELECT 'StartSel=' || CHR(2) || ', StopSel=' || CHR(3) AS selectors) h
+ORDER BY m.position;
\ No newline at end of file
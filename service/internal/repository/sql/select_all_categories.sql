@@ -0,0 +1,3 @@
+SELECT id, name, slug, description, parent_id
+FROM categories
+ORDER BY parent_id NULLS FIRST, name, id;
\ No newline at end of file
@@ -0,0 +1,5 @@
+SELECT n.id
+FROM news n
+WHERE %s
+ORDER BY n.id
+FOR UPDATE;
\ No newline at end of file
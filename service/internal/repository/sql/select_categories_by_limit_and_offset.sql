@@ -0,0 +1,4 @@
+SELECT id, name, slug, description, parent_id
+FROM categories
+ORDER BY id
+    LIMIT $1 OFFSET $2;
\ No newline at end of file
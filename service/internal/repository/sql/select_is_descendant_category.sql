@@ -0,0 +1 @@
+SELECT EXISTS(SELECT 1 FROM category_closure WHERE ancestor_id = $1 AND descendant_id = $2);
\ No newline at end of file
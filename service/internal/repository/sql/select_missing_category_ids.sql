@@ -0,0 +1,5 @@
+SELECT DISTINCT u.id
+FROM unnest($1::bigint[]) AS u(id)
+         LEFT JOIN categories c ON c.id = u.id
+WHERE c.id IS NULL
+ORDER BY u.id;
\ No newline at end of file
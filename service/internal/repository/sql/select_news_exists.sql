@@ -0,0 +1 @@
+SELECT EXISTS(SELECT 1 FROM news WHERE id = $1 AND deleted_at IS NULL);
\ No newline at end of file
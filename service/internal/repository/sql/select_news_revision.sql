@@ -0,0 +1,4 @@
+SELECT id, news_id, revision, title, content, categories, editor, created_at
+FROM news_revisions
+WHERE news_id = $1
+  AND revision = $2;
\ No newline at end of file
@@ -0,0 +1,5 @@
+SELECT news_id, locale, title, content, updated_at
+FROM news_translations
+WHERE news_id = ANY ($1::BIGINT[])
+  AND locale = ANY ($2::TEXT[])
+ORDER BY news_id, locale;
\ No newline at end of file
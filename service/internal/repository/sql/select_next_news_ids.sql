@@ -0,0 +1,2 @@
+SELECT NEXTVAL(PG_GET_SERIAL_SEQUENCE('news', 'id'))
+FROM GENERATE_SERIES(1, $1);
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT id FROM news WHERE deleted_at IS NOT NULL AND deleted_at < $1 FOR UPDATE
\ No newline at end of file
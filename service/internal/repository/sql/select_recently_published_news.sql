@@ -0,0 +1,10 @@
+SELECT n.id,
+       n.title,
+       n.updated_at,
+       n.published_at
+FROM news n
+WHERE n.status = 'published'
+  AND n.deleted_at IS NULL
+  AND n.published_at >= $1
+ORDER BY n.published_at DESC, n.id DESC
+    LIMIT $2;
\ No newline at end of file
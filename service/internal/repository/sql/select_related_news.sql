@@ -0,0 +1,52 @@
+WITH source AS (SELECT n.id,
+                       n.title,
+                       ARRAY(SELECT nc.category_id FROM news_categories nc WHERE nc.news_id = n.id) AS categories
+                FROM news n
+                WHERE n.id = $1
+                  AND n.deleted_at IS NULL),
+     candidates AS (SELECT nc.news_id AS id
+                    FROM news_categories nc
+                             CROSS JOIN source s
+                    WHERE nc.category_id = ANY (s.categories)
+                    UNION
+                    SELECT n.id
+                    FROM news n
+                             CROSS JOIN source s
+                    WHERE n.title % s.title),
+     scored AS (SELECT n.*,
+                       $2::FLOAT8 * COALESCE(cat.shared::FLOAT8 / NULLIF(CARDINALITY(s.categories) + cat.total - cat.shared, 0), 0)
+                           + $3::FLOAT8 * similarity(n.title, s.title)
+                           + $4::FLOAT8 * POWER(0.5::FLOAT8, LEAST(GREATEST(EXTRACT(EPOCH FROM NOW() - COALESCE(n.published_at, n.created_at))::FLOAT8, 0) / 86400 / $5::FLOAT8, 1000)) AS score
+                FROM candidates c
+                         JOIN news n ON n.id = c.id
+                         CROSS JOIN source s
+                         CROSS JOIN LATERAL (SELECT COUNT(*) FILTER (WHERE x.category_id = ANY (s.categories)) AS shared,
+                                                    COUNT(*)                                                  AS total
+                                             FROM news_categories x
+                                             WHERE x.news_id = n.id) cat
+                WHERE n.id <> s.id
+                  AND n.status = 'published'
+                  AND n.deleted_at IS NULL
+                ORDER BY score DESC, n.id DESC
+                LIMIT $6)
+SELECT r.id,
+       r.title,
+       r.content,
+       r.content_format,
+       r.status,
+       r.publish_at,
+       r.expires_at,
+       r.created_at,
+       r.updated_at,
+       r.published_at,
+       r.deleted_at,
+       r.version,
+       COALESCE((SELECT ARRAY_AGG(nc.category_id ORDER BY nc.category_id)
+                 FROM news_categories nc
+                 WHERE nc.news_id = r.id), '{}') AS categories,
+       COALESCE((SELECT ARRAY_AGG(nm.media_id ORDER BY nm.position)
+                 FROM news_media nm
+                 WHERE nm.news_id = r.id), '{}') AS media,
+       r.score
+FROM scored r
+ORDER BY r.score DESC, r.id DESC;
\ No newline at end of file
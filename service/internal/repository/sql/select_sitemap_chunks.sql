@@ -0,0 +1,7 @@
+SELECT (n.id - 1) / $1 + 1 AS chunk,
+       MAX(n.updated_at)     AS last_mod
+FROM news n
+WHERE n.status = 'published'
+  AND n.deleted_at IS NULL
+GROUP BY chunk
+ORDER BY chunk;
\ No newline at end of file
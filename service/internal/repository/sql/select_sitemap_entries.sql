@@ -0,0 +1,10 @@
+SELECT n.id,
+       n.title,
+       n.updated_at,
+       n.published_at
+FROM news n
+WHERE n.status = 'published'
+  AND n.deleted_at IS NULL
+  AND n.id > $1
+  AND n.id <= $2
+ORDER BY n.id;
\ No newline at end of file
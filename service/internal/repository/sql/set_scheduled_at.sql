@@ -0,0 +1 @@
+SELECT set_config('news.scheduled_at', $1, true)
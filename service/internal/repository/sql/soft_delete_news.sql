@@ -0,0 +1 @@
+UPDATE news SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL
\ No newline at end of file
@@ -0,0 +1 @@
+UPDATE news SET updated_at = NOW() WHERE id = ANY ($1::BIGINT[]);
\ No newline at end of file
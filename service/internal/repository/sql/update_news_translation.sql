@@ -0,0 +1,6 @@
+UPDATE news_translations
+SET title      = COALESCE($3, title),
+    content    = COALESCE($4, content),
+    updated_at = NOW()
+WHERE news_id = $1
+  AND locale = $2;
\ No newline at end of file
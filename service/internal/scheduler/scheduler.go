@@ -0,0 +1,107 @@
+package scheduler
+
+import (
+	"context"
+	"service/internal/repository"
+	"service/pkg/clock"
+	"service/pkg/logger"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Scheduler periodically publishes news whose PublishAt has come and archives news whose ExpiresAt has passed.
+// Replicas coordinate through a PostgreSQL advisory lock, so every run happens on one replica only.
+type Scheduler struct {
+	repo     repository.INewsRepository
+	clock    clock.Clock
+	interval time.Duration
+	log      *logger.Logger
+
+	mu      sync.Mutex
+	running bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+func NewScheduler(repo repository.INewsRepository, clock clock.Clock, interval time.Duration, log *logger.Logger) *Scheduler {
+	return &Scheduler{
+		repo:     repo,
+		clock:    clock,
+		interval: interval,
+		log:      log,
+	}
+}
+
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return
+	}
+	s.running = true
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+
+	stop, done := s.stop, s.done
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.RunOnce()
+		for {
+			select {
+			case <-ticker.C:
+				s.RunOnce()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.running = false
+	close(s.stop)
+	done := s.done
+	s.mu.Unlock()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) RunOnce() {
+	const op = "scheduler.RunOnce"
+
+	result, err := s.repo.RunScheduledTransitions(s.clock.Now())
+	if err != nil {
+		s.log.WithError(err).WithField("operation", op).Error("Scheduled transitions failed")
+		return
+	}
+
+	if !result.Locked {
+		s.log.WithField("operation", op).Debug("Scheduler lock is held by another replica")
+		return
+	}
+
+	if result.Published > 0 || result.Archived > 0 {
+		s.log.WithFields(logrus.Fields{
+			"operation": op,
+			"published": result.Published,
+			"archived":  result.Archived,
+		}).Info("Scheduled transitions applied")
+	}
+}
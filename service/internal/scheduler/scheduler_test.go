@@ -0,0 +1,117 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"service/internal/models"
+	"service/internal/repository/mocks"
+	customLog "service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testLogger = func() *customLog.Logger {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	return &customLog.Logger{Logger: log}
+}()
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func setupRepo(t *testing.T) *mocks.INewsRepository {
+	mockRepo := new(mocks.INewsRepository)
+
+	t.Cleanup(func() {
+		mockRepo.AssertExpectations(t)
+	})
+
+	return mockRepo
+}
+
+func TestRunOnce(t *testing.T) {
+	now := time.Date(2025, 12, 21, 6, 0, 0, 0, time.UTC)
+
+	t.Run("UsesInjectedClock", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+		clock := &fakeClock{now: now}
+
+		mockRepo.On("RunScheduledTransitions", now).
+			Return(models.ScheduleResult{Locked: true, Published: 2, Archived: 1}, nil).Once()
+		later := now.Add(time.Hour)
+		mockRepo.On("RunScheduledTransitions", later).
+			Return(models.ScheduleResult{Locked: true}, nil).Once()
+
+		scheduler := NewScheduler(mockRepo, clock, time.Minute, testLogger)
+
+		scheduler.RunOnce()
+		clock.now = later
+		scheduler.RunOnce()
+	})
+
+	t.Run("LockHeldByAnotherReplica", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+
+		mockRepo.On("RunScheduledTransitions", now).Return(models.ScheduleResult{Locked: false}, nil).Once()
+
+		scheduler := NewScheduler(mockRepo, &fakeClock{now: now}, time.Minute, testLogger)
+
+		scheduler.RunOnce()
+	})
+
+	t.Run("RepositoryError", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+
+		mockRepo.On("RunScheduledTransitions", now).Return(models.ScheduleResult{}, errors.New("database error")).Once()
+
+		scheduler := NewScheduler(mockRepo, &fakeClock{now: now}, time.Minute, testLogger)
+
+		scheduler.RunOnce()
+	})
+}
+
+func TestStartStop(t *testing.T) {
+	t.Run("RunsImmediatelyAndStops", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+		ran := make(chan struct{}, 1)
+
+		mockRepo.On("RunScheduledTransitions", mock.Anything).
+			Return(models.ScheduleResult{Locked: true}, nil).
+			Run(func(mock.Arguments) {
+				select {
+				case ran <- struct{}{}:
+				default:
+				}
+			})
+
+		scheduler := NewScheduler(mockRepo, &fakeClock{now: time.Now()}, time.Hour, testLogger)
+		scheduler.Start()
+
+		select {
+		case <-ran:
+		case <-time.After(time.Second):
+			t.Fatal("scheduler did not run")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		assert.NoError(t, scheduler.Stop(ctx))
+	})
+
+	t.Run("StopWithoutStart", func(t *testing.T) {
+		scheduler := NewScheduler(setupRepo(t), &fakeClock{}, time.Hour, testLogger)
+
+		assert.NoError(t, scheduler.Stop(context.Background()))
+	})
+}
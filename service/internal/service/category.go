@@ -0,0 +1,85 @@
+package service
+
+import (
+	"service/internal/models"
+	"service/internal/repository"
+	"service/pkg/logger"
+)
+
+//go:generate mockery --name=ICategoryService --output=mocks --outpkg=mocks --case=snake --with-expecter
+type ICategoryService interface {
+	CreateCategory(createForm models.CategoryCreateForm) (int64, error)
+	EditCategory(categoryId int64, editForm models.CategoryEditForm) error
+	GetCategory(categoryId int64) (models.Category, error)
+	ListCategories(limit, offset int64) ([]models.Category, error)
+	DeleteCategory(categoryId int64) error
+	GetCategoryTree() ([]models.CategoryNode, error)
+}
+
+type CategoryService struct {
+	repo repository.ICategoryRepository
+	log  *logger.Logger
+}
+
+func NewCategoryService(repo repository.ICategoryRepository, log *logger.Logger) ICategoryService {
+	return &CategoryService{
+		repo: repo,
+		log:  log,
+	}
+}
+
+func (s *CategoryService) CreateCategory(createForm models.CategoryCreateForm) (int64, error) {
+	return s.repo.CreateCategory(createForm)
+}
+
+func (s *CategoryService) EditCategory(categoryId int64, editForm models.CategoryEditForm) error {
+	return s.repo.UpdateCategory(categoryId, editForm)
+}
+
+func (s *CategoryService) GetCategory(categoryId int64) (models.Category, error) {
+	return s.repo.GetCategoryByID(categoryId)
+}
+
+func (s *CategoryService) ListCategories(limit, offset int64) ([]models.Category, error) {
+	categories, err := s.repo.GetCategories(limit, offset)
+	if err != nil {
+		return []models.Category{}, err
+	}
+
+	return categories, nil
+}
+
+func (s *CategoryService) DeleteCategory(categoryId int64) error {
+	return s.repo.DeleteCategory(categoryId)
+}
+
+func (s *CategoryService) GetCategoryTree() ([]models.CategoryNode, error) {
+	categories, err := s.repo.GetAllCategories()
+	if err != nil {
+		return []models.CategoryNode{}, err
+	}
+
+	children := make(map[int64][]models.Category)
+	roots := make([]models.Category, 0)
+	for _, category := range categories {
+		if category.ParentId == nil {
+			roots = append(roots, category)
+			continue
+		}
+		children[*category.ParentId] = append(children[*category.ParentId], category)
+	}
+
+	return buildCategoryNodes(roots, children), nil
+}
+
+func buildCategoryNodes(categories []models.Category, children map[int64][]models.Category) []models.CategoryNode {
+	nodes := make([]models.CategoryNode, 0, len(categories))
+	for _, category := range categories {
+		nodes = append(nodes, models.CategoryNode{
+			Category: category,
+			Children: buildCategoryNodes(children[category.ID], children),
+		})
+	}
+
+	return nodes
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"service/internal/apperrors"
+	"service/internal/models"
+	"service/internal/repository/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupCategoryRepo(t *testing.T) *mocks.ICategoryRepository {
+	mockRepo := new(mocks.ICategoryRepository)
+
+	t.Cleanup(func() {
+		mockRepo.AssertExpectations(t)
+	})
+
+	return mockRepo
+}
+
+func TestCreateCategory(t *testing.T) {
+	createForm := models.CategoryCreateForm{
+		Name: "Sport",
+		Slug: "sport",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := setupCategoryRepo(t)
+
+		mockRepo.On("CreateCategory", createForm).Return(int64(1), nil)
+		service := NewCategoryService(mockRepo, testLogger)
+
+		id, err := service.CreateCategory(createForm)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), id)
+	})
+
+	t.Run("FailedDuplicateSlug", func(t *testing.T) {
+		mockRepo := setupCategoryRepo(t)
+
+		mockRepo.On("CreateCategory", createForm).Return(int64(0), apperrors.NewConflict("Category with slug \"sport\" already exists"))
+		service := NewCategoryService(mockRepo, testLogger)
+
+		_, err := service.CreateCategory(createForm)
+
+		assert.ErrorIs(t, err, apperrors.ErrConflict)
+	})
+}
+
+func TestListCategories(t *testing.T) {
+	categories := []models.Category{
+		{ID: 1, Name: "Sport", Slug: "sport"},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := setupCategoryRepo(t)
+
+		mockRepo.On("GetCategories", int64(10), int64(0)).Return(categories, nil)
+		service := NewCategoryService(mockRepo, testLogger)
+
+		actual, err := service.ListCategories(10, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, categories, actual)
+	})
+}
+
+func TestDeleteCategory(t *testing.T) {
+	t.Run("FailedNotFound", func(t *testing.T) {
+		mockRepo := setupCategoryRepo(t)
+
+		mockRepo.On("DeleteCategory", int64(9)).Return(apperrors.NewCategoryNotFound("Category not found"))
+		service := NewCategoryService(mockRepo, testLogger)
+
+		err := service.DeleteCategory(9)
+
+		assert.ErrorIs(t, err, apperrors.ErrCategoryNotFound)
+	})
+}
+
+func TestGetCategoryTree(t *testing.T) {
+	sportID, footballID := int64(1), int64(2)
+	categories := []models.Category{
+		{ID: sportID, Name: "Sport", Slug: "sport"},
+		{ID: 4, Name: "Politics", Slug: "politics"},
+		{ID: footballID, Name: "Football", Slug: "football", ParentId: &sportID},
+		{ID: 3, Name: "Premier League", Slug: "premier-league", ParentId: &footballID},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := setupCategoryRepo(t)
+
+		mockRepo.On("GetAllCategories").Return(categories, nil)
+		service := NewCategoryService(mockRepo, testLogger)
+
+		tree, err := service.GetCategoryTree()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.CategoryNode{
+			{
+				Category: categories[0],
+				Children: []models.CategoryNode{
+					{
+						Category: categories[2],
+						Children: []models.CategoryNode{
+							{Category: categories[3], Children: []models.CategoryNode{}},
+						},
+					},
+				},
+			},
+			{Category: categories[1], Children: []models.CategoryNode{}},
+		}, tree)
+	})
+}
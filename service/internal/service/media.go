@@ -0,0 +1,207 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"service/internal/apperrors"
+	"service/internal/configs"
+	"service/internal/models"
+	"service/internal/repository"
+	"service/pkg/imaging"
+	"service/pkg/logger"
+	"service/pkg/storage"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockery --name=IMediaService --output=mocks --outpkg=mocks --case=snake --with-expecter
+type IMediaService interface {
+	UploadMedia(ctx context.Context, fileName string, data []byte) (models.Media, error)
+	GetMedia(mediaId int64) (models.Media, error)
+	OpenMediaFile(ctx context.Context, mediaId int64, size int) (models.MediaFile, error)
+	DeleteMedia(ctx context.Context, mediaId int64) error
+}
+
+type MediaService struct {
+	repo   repository.IMediaRepository
+	store  storage.BlobStore
+	log    *logger.Logger
+	config configs.Media
+}
+
+func NewMediaService(repo repository.IMediaRepository, store storage.BlobStore, log *logger.Logger, config configs.Media) IMediaService {
+	return &MediaService{
+		repo:   repo,
+		store:  store,
+		log:    log,
+		config: config,
+	}
+}
+
+// UploadMedia checks the type of an uploaded image by its content, re-encodes it without metadata
+// and saves it with its thumbnails.
+func (s *MediaService) UploadMedia(ctx context.Context, fileName string, data []byte) (models.Media, error) {
+	img, err := imaging.Decode(data, s.config.MaxPixels)
+	if errors.Is(err, imaging.ErrUnsupported) {
+		return models.Media{}, apperrors.NewValidation("file: must be a JPEG, PNG or GIF image")
+	}
+	if errors.Is(err, imaging.ErrTooLarge) {
+		return models.Media{}, apperrors.NewValidation(fmt.Sprintf("file: image must have at most %d pixels", s.config.MaxPixels))
+	}
+	if err != nil {
+		return models.Media{}, err
+	}
+
+	media := models.Media{
+		FileName:    truncateRunes(fileName, models.MediaMaxFileNameLength),
+		ContentType: img.ContentType,
+		Size:        int64(len(img.Data)),
+		Width:       img.Width,
+		Height:      img.Height,
+		Thumbnails:  make(models.Thumbnails, 0, len(s.config.ThumbnailSizes)),
+	}
+
+	files := map[int][]byte{0: img.Data}
+	for _, size := range s.config.ThumbnailSizes {
+		thumbnail, err := img.Thumbnail(size)
+		if err != nil {
+			return models.Media{}, err
+		}
+
+		files[size] = thumbnail.Data
+		media.Thumbnails = append(media.Thumbnails, models.Thumbnail{
+			Size:        size,
+			Width:       thumbnail.Width,
+			Height:      thumbnail.Height,
+			ContentType: thumbnail.ContentType,
+		})
+	}
+
+	saved := make([]string, 0, len(files))
+	created, err := s.repo.CreateMedia(media, func(mediaId int64) error {
+		media.ID = mediaId
+		for _, file := range mediaFiles(media) {
+			if err := s.store.Put(ctx, file.key, bytes.NewReader(files[file.size])); err != nil {
+				return err
+			}
+			saved = append(saved, file.key)
+		}
+		return nil
+	})
+	if err != nil {
+		s.removeFiles(ctx, saved)
+		return models.Media{}, err
+	}
+
+	return created, nil
+}
+
+func (s *MediaService) GetMedia(mediaId int64) (models.Media, error) {
+	return s.repo.GetMediaByID(mediaId)
+}
+
+// OpenMediaFile opens the original file of media, or its thumbnail of the given size when size is not 0.
+func (s *MediaService) OpenMediaFile(ctx context.Context, mediaId int64, size int) (models.MediaFile, error) {
+	const op = "service.media.OpenMediaFile"
+
+	media, err := s.repo.GetMediaByID(mediaId)
+	if err != nil {
+		return models.MediaFile{}, err
+	}
+
+	for _, file := range mediaFiles(media) {
+		if file.size != size {
+			continue
+		}
+
+		body, err := s.store.Get(ctx, file.key)
+		if errors.Is(err, storage.ErrNotFound) {
+			s.log.WithFields(logrus.Fields{
+				"operation": op,
+				"media_id":  mediaId,
+				"key":       file.key,
+			}).Error("Media file is missing from storage")
+			return models.MediaFile{}, apperrors.NewMediaNotFound("Media file not found")
+		}
+		if err != nil {
+			return models.MediaFile{}, err
+		}
+
+		return models.MediaFile{ContentType: file.contentType, Body: body}, nil
+	}
+
+	return models.MediaFile{}, apperrors.NewMediaNotFound(fmt.Sprintf("Thumbnail of size %d not found", size))
+}
+
+// DeleteMedia deletes media no news is using, then its files.
+func (s *MediaService) DeleteMedia(ctx context.Context, mediaId int64) error {
+	media, err := s.repo.GetMediaByID(mediaId)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repo.DeleteMedia(mediaId); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(media.Thumbnails)+1)
+	for _, file := range mediaFiles(media) {
+		keys = append(keys, file.key)
+	}
+	s.removeFiles(ctx, keys)
+
+	return nil
+}
+
+// removeFiles deletes files left by media that is not stored. Failures are only logged, as the media is gone either way.
+func (s *MediaService) removeFiles(ctx context.Context, keys []string) {
+	const op = "service.media.removeFiles"
+
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			s.log.WithError(err).WithFields(logrus.Fields{
+				"operation": op,
+				"key":       key,
+			}).Error("Failed to delete media file")
+		}
+	}
+}
+
+type mediaFile struct {
+	key         string
+	size        int
+	contentType string
+}
+
+// mediaFiles lists the stored files of media: the original, with size 0, and its thumbnails.
+func mediaFiles(media models.Media) []mediaFile {
+	files := []mediaFile{{
+		key:         mediaFileKey(media.ID, "original", media.ContentType),
+		contentType: media.ContentType,
+	}}
+
+	for _, thumbnail := range media.Thumbnails {
+		files = append(files, mediaFile{
+			key:         mediaFileKey(media.ID, strconv.Itoa(thumbnail.Size), thumbnail.ContentType),
+			size:        thumbnail.Size,
+			contentType: thumbnail.ContentType,
+		})
+	}
+
+	return files
+}
+
+func mediaFileKey(mediaId int64, name, contentType string) string {
+	return fmt.Sprintf("media/%d/%s%s", mediaId, name, imaging.Extension(contentType))
+}
+
+func truncateRunes(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit])
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"service/internal/apperrors"
+	"service/internal/configs"
+	"service/internal/models"
+	"service/internal/repository/mocks"
+	"service/pkg/storage"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testMediaConfig = configs.Media{
+	MaxSizeMB:      10,
+	MaxPixels:      1000000,
+	ThumbnailSizes: []int{320, 640},
+}
+
+func setupMediaRepo(t *testing.T) *mocks.IMediaRepository {
+	mockRepo := new(mocks.IMediaRepository)
+
+	t.Cleanup(func() {
+		mockRepo.AssertExpectations(t)
+	})
+
+	return mockRepo
+}
+
+func setupMediaStore(t *testing.T) *storage.FileStore {
+	store, err := storage.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	return store
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+
+	return buf.Bytes()
+}
+
+func readBlob(t *testing.T, store storage.BlobStore, key string) ([]byte, error) {
+	reader, err := store.Get(context.Background(), key)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	return data, nil
+}
+
+func testMedia() models.Media {
+	return models.Media{
+		ID:          7,
+		FileName:    "photo.png",
+		ContentType: "image/png",
+		Width:       800,
+		Height:      600,
+		Thumbnails: models.Thumbnails{
+			{Size: 320, Width: 320, Height: 240, ContentType: "image/png"},
+		},
+	}
+}
+
+func TestUploadMedia(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := setupMediaRepo(t)
+		store := setupMediaStore(t)
+
+		mockRepo.On("CreateMedia", mock.Anything, mock.Anything).Return(
+			func(media models.Media, save func(int64) error) (models.Media, error) {
+				media.ID = 7
+				return media, save(media.ID)
+			}, nil)
+		service := NewMediaService(mockRepo, store, testLogger, testMediaConfig)
+
+		media, err := service.UploadMedia(ctx, "photo.png", testPNG(t, 800, 600))
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), media.ID)
+		assert.Equal(t, "photo.png", media.FileName)
+		assert.Equal(t, "image/png", media.ContentType)
+		assert.Equal(t, 800, media.Width)
+		assert.Equal(t, 600, media.Height)
+		assert.Equal(t, models.Thumbnails{
+			{Size: 320, Width: 320, Height: 240, ContentType: "image/png"},
+			{Size: 640, Width: 640, Height: 480, ContentType: "image/png"},
+		}, media.Thumbnails)
+
+		for _, key := range []string{"media/7/original.png", "media/7/320.png", "media/7/640.png"} {
+			data, err := readBlob(t, store, key)
+			assert.NoError(t, err, key)
+			assert.NotEmpty(t, data, key)
+		}
+		original, _ := readBlob(t, store, "media/7/original.png")
+		assert.Equal(t, media.Size, int64(len(original)))
+	})
+
+	t.Run("LongFileName", func(t *testing.T) {
+		mockRepo := setupMediaRepo(t)
+
+		mockRepo.On("CreateMedia", mock.Anything, mock.Anything).Return(
+			func(media models.Media, save func(int64) error) (models.Media, error) {
+				return media, nil
+			}, nil)
+		service := NewMediaService(mockRepo, setupMediaStore(t), testLogger, testMediaConfig)
+
+		media, err := service.UploadMedia(ctx, strings.Repeat("я", 300)+".png", testPNG(t, 10, 10))
+
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Repeat("я", models.MediaMaxFileNameLength), media.FileName)
+	})
+
+	t.Run("FailedUnsupportedType", func(t *testing.T) {
+		service := NewMediaService(setupMediaRepo(t), setupMediaStore(t), testLogger, testMediaConfig)
+
+		_, err := service.UploadMedia(ctx, "photo.png", []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"/>"))
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.EqualError(t, err, "file: must be a JPEG, PNG or GIF image")
+	})
+
+	t.Run("FailedTooManyPixels", func(t *testing.T) {
+		service := NewMediaService(setupMediaRepo(t), setupMediaStore(t), testLogger, testMediaConfig)
+
+		_, err := service.UploadMedia(ctx, "photo.png", testPNG(t, 1001, 1000))
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.EqualError(t, err, "file: image must have at most 1000000 pixels")
+	})
+
+	t.Run("FailedCommitRemovesFiles", func(t *testing.T) {
+		mockRepo := setupMediaRepo(t)
+		store := setupMediaStore(t)
+
+		mockRepo.On("CreateMedia", mock.Anything, mock.Anything).Return(
+			func(media models.Media, save func(int64) error) (models.Media, error) {
+				require.NoError(t, save(7))
+				return models.Media{}, errors.New("failed to commit transaction")
+			}, nil)
+		service := NewMediaService(mockRepo, store, testLogger, testMediaConfig)
+
+		_, err := service.UploadMedia(ctx, "photo.png", testPNG(t, 800, 600))
+
+		assert.Error(t, err)
+		for _, key := range []string{"media/7/original.png", "media/7/320.png", "media/7/640.png"} {
+			_, err := readBlob(t, store, key)
+			assert.ErrorIs(t, err, storage.ErrNotFound, key)
+		}
+	})
+}
+
+func TestOpenMediaFile(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("SuccessThumbnail", func(t *testing.T) {
+		mockRepo := setupMediaRepo(t)
+		store := setupMediaStore(t)
+		require.NoError(t, store.Put(ctx, "media/7/320.png", strings.NewReader("thumbnail")))
+
+		mockRepo.On("GetMediaByID", int64(7)).Return(testMedia(), nil)
+		service := NewMediaService(mockRepo, store, testLogger, testMediaConfig)
+
+		file, err := service.OpenMediaFile(ctx, 7, 320)
+
+		require.NoError(t, err)
+		defer file.Body.Close()
+		data, _ := io.ReadAll(file.Body)
+		assert.Equal(t, "image/png", file.ContentType)
+		assert.Equal(t, "thumbnail", string(data))
+	})
+
+	t.Run("FailedUnknownSize", func(t *testing.T) {
+		mockRepo := setupMediaRepo(t)
+
+		mockRepo.On("GetMediaByID", int64(7)).Return(testMedia(), nil)
+		service := NewMediaService(mockRepo, setupMediaStore(t), testLogger, testMediaConfig)
+
+		_, err := service.OpenMediaFile(ctx, 7, 100)
+
+		assert.ErrorIs(t, err, apperrors.ErrMediaNotFound)
+		assert.EqualError(t, err, "Thumbnail of size 100 not found")
+	})
+
+	t.Run("FailedMissingFile", func(t *testing.T) {
+		mockRepo := setupMediaRepo(t)
+
+		mockRepo.On("GetMediaByID", int64(7)).Return(testMedia(), nil)
+		service := NewMediaService(mockRepo, setupMediaStore(t), testLogger, testMediaConfig)
+
+		_, err := service.OpenMediaFile(ctx, 7, 0)
+
+		assert.ErrorIs(t, err, apperrors.ErrMediaNotFound)
+	})
+}
+
+func TestDeleteMedia(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := setupMediaRepo(t)
+		store := setupMediaStore(t)
+		require.NoError(t, store.Put(ctx, "media/7/original.png", strings.NewReader("original")))
+		require.NoError(t, store.Put(ctx, "media/7/320.png", strings.NewReader("thumbnail")))
+
+		mockRepo.On("GetMediaByID", int64(7)).Return(testMedia(), nil)
+		mockRepo.On("DeleteMedia", int64(7)).Return(nil)
+		service := NewMediaService(mockRepo, store, testLogger, testMediaConfig)
+
+		err := service.DeleteMedia(ctx, 7)
+
+		assert.NoError(t, err)
+		for _, key := range []string{"media/7/original.png", "media/7/320.png"} {
+			_, err := readBlob(t, store, key)
+			assert.ErrorIs(t, err, storage.ErrNotFound, key)
+		}
+	})
+
+	t.Run("FailedAttachedKeepsFiles", func(t *testing.T) {
+		mockRepo := setupMediaRepo(t)
+		store := setupMediaStore(t)
+		require.NoError(t, store.Put(ctx, "media/7/original.png", strings.NewReader("original")))
+
+		mockRepo.On("GetMediaByID", int64(7)).Return(testMedia(), nil)
+		mockRepo.On("DeleteMedia", int64(7)).Return(apperrors.NewConflict("Media is attached to news, detach it first"))
+		service := NewMediaService(mockRepo, store, testLogger, testMediaConfig)
+
+		err := service.DeleteMedia(ctx, 7)
+
+		assert.ErrorIs(t, err, apperrors.ErrConflict)
+		data, err := readBlob(t, store, "media/7/original.png")
+		assert.NoError(t, err)
+		assert.Equal(t, "original", string(data))
+	})
+}
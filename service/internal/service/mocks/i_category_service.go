@@ -0,0 +1,357 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICategoryService is an autogenerated mock type for the ICategoryService type
+type ICategoryService struct {
+	mock.Mock
+}
+
+type ICategoryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ICategoryService) EXPECT() *ICategoryService_Expecter {
+	return &ICategoryService_Expecter{mock: &_m.Mock}
+}
+
+// CreateCategory provides a mock function with given fields: createForm
+func (_m *ICategoryService) CreateCategory(createForm models.CategoryCreateForm) (int64, error) {
+	ret := _m.Called(createForm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.CategoryCreateForm) (int64, error)); ok {
+		return rf(createForm)
+	}
+	if rf, ok := ret.Get(0).(func(models.CategoryCreateForm) int64); ok {
+		r0 = rf(createForm)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.CategoryCreateForm) error); ok {
+		r1 = rf(createForm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICategoryService_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type ICategoryService_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - createForm models.CategoryCreateForm
+func (_e *ICategoryService_Expecter) CreateCategory(createForm interface{}) *ICategoryService_CreateCategory_Call {
+	return &ICategoryService_CreateCategory_Call{Call: _e.mock.On("CreateCategory", createForm)}
+}
+
+func (_c *ICategoryService_CreateCategory_Call) Run(run func(createForm models.CategoryCreateForm)) *ICategoryService_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.CategoryCreateForm))
+	})
+	return _c
+}
+
+func (_c *ICategoryService_CreateCategory_Call) Return(_a0 int64, _a1 error) *ICategoryService_CreateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICategoryService_CreateCategory_Call) RunAndReturn(run func(models.CategoryCreateForm) (int64, error)) *ICategoryService_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: categoryId
+func (_m *ICategoryService) DeleteCategory(categoryId int64) error {
+	ret := _m.Called(categoryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(categoryId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ICategoryService_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type ICategoryService_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - categoryId int64
+func (_e *ICategoryService_Expecter) DeleteCategory(categoryId interface{}) *ICategoryService_DeleteCategory_Call {
+	return &ICategoryService_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", categoryId)}
+}
+
+func (_c *ICategoryService_DeleteCategory_Call) Run(run func(categoryId int64)) *ICategoryService_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *ICategoryService_DeleteCategory_Call) Return(_a0 error) *ICategoryService_DeleteCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ICategoryService_DeleteCategory_Call) RunAndReturn(run func(int64) error) *ICategoryService_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditCategory provides a mock function with given fields: categoryId, editForm
+func (_m *ICategoryService) EditCategory(categoryId int64, editForm models.CategoryEditForm) error {
+	ret := _m.Called(categoryId, editForm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, models.CategoryEditForm) error); ok {
+		r0 = rf(categoryId, editForm)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ICategoryService_EditCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditCategory'
+type ICategoryService_EditCategory_Call struct {
+	*mock.Call
+}
+
+// EditCategory is a helper method to define mock.On call
+//   - categoryId int64
+//   - editForm models.CategoryEditForm
+func (_e *ICategoryService_Expecter) EditCategory(categoryId interface{}, editForm interface{}) *ICategoryService_EditCategory_Call {
+	return &ICategoryService_EditCategory_Call{Call: _e.mock.On("EditCategory", categoryId, editForm)}
+}
+
+func (_c *ICategoryService_EditCategory_Call) Run(run func(categoryId int64, editForm models.CategoryEditForm)) *ICategoryService_EditCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(models.CategoryEditForm))
+	})
+	return _c
+}
+
+func (_c *ICategoryService_EditCategory_Call) Return(_a0 error) *ICategoryService_EditCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ICategoryService_EditCategory_Call) RunAndReturn(run func(int64, models.CategoryEditForm) error) *ICategoryService_EditCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategory provides a mock function with given fields: categoryId
+func (_m *ICategoryService) GetCategory(categoryId int64) (models.Category, error) {
+	ret := _m.Called(categoryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategory")
+	}
+
+	var r0 models.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (models.Category, error)); ok {
+		return rf(categoryId)
+	}
+	if rf, ok := ret.Get(0).(func(int64) models.Category); ok {
+		r0 = rf(categoryId)
+	} else {
+		r0 = ret.Get(0).(models.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(categoryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICategoryService_GetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategory'
+type ICategoryService_GetCategory_Call struct {
+	*mock.Call
+}
+
+// GetCategory is a helper method to define mock.On call
+//   - categoryId int64
+func (_e *ICategoryService_Expecter) GetCategory(categoryId interface{}) *ICategoryService_GetCategory_Call {
+	return &ICategoryService_GetCategory_Call{Call: _e.mock.On("GetCategory", categoryId)}
+}
+
+func (_c *ICategoryService_GetCategory_Call) Run(run func(categoryId int64)) *ICategoryService_GetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *ICategoryService_GetCategory_Call) Return(_a0 models.Category, _a1 error) *ICategoryService_GetCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICategoryService_GetCategory_Call) RunAndReturn(run func(int64) (models.Category, error)) *ICategoryService_GetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryTree provides a mock function with no fields
+func (_m *ICategoryService) GetCategoryTree() ([]models.CategoryNode, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryTree")
+	}
+
+	var r0 []models.CategoryNode
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.CategoryNode, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.CategoryNode); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CategoryNode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICategoryService_GetCategoryTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryTree'
+type ICategoryService_GetCategoryTree_Call struct {
+	*mock.Call
+}
+
+// GetCategoryTree is a helper method to define mock.On call
+func (_e *ICategoryService_Expecter) GetCategoryTree() *ICategoryService_GetCategoryTree_Call {
+	return &ICategoryService_GetCategoryTree_Call{Call: _e.mock.On("GetCategoryTree")}
+}
+
+func (_c *ICategoryService_GetCategoryTree_Call) Run(run func()) *ICategoryService_GetCategoryTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ICategoryService_GetCategoryTree_Call) Return(_a0 []models.CategoryNode, _a1 error) *ICategoryService_GetCategoryTree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICategoryService_GetCategoryTree_Call) RunAndReturn(run func() ([]models.CategoryNode, error)) *ICategoryService_GetCategoryTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: limit, offset
+func (_m *ICategoryService) ListCategories(limit int64, offset int64) ([]models.Category, error) {
+	ret := _m.Called(limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []models.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) ([]models.Category, error)); ok {
+		return rf(limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) []models.Category); ok {
+		r0 = rf(limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICategoryService_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type ICategoryService_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - limit int64
+//   - offset int64
+func (_e *ICategoryService_Expecter) ListCategories(limit interface{}, offset interface{}) *ICategoryService_ListCategories_Call {
+	return &ICategoryService_ListCategories_Call{Call: _e.mock.On("ListCategories", limit, offset)}
+}
+
+func (_c *ICategoryService_ListCategories_Call) Run(run func(limit int64, offset int64)) *ICategoryService_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ICategoryService_ListCategories_Call) Return(_a0 []models.Category, _a1 error) *ICategoryService_ListCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICategoryService_ListCategories_Call) RunAndReturn(run func(int64, int64) ([]models.Category, error)) *ICategoryService_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewICategoryService creates a new instance of ICategoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICategoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICategoryService {
+	mock := &ICategoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,256 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	models "service/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IMediaService is an autogenerated mock type for the IMediaService type
+type IMediaService struct {
+	mock.Mock
+}
+
+type IMediaService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IMediaService) EXPECT() *IMediaService_Expecter {
+	return &IMediaService_Expecter{mock: &_m.Mock}
+}
+
+// DeleteMedia provides a mock function with given fields: ctx, mediaId
+func (_m *IMediaService) DeleteMedia(ctx context.Context, mediaId int64) error {
+	ret := _m.Called(ctx, mediaId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, mediaId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMediaService_DeleteMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMedia'
+type IMediaService_DeleteMedia_Call struct {
+	*mock.Call
+}
+
+// DeleteMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mediaId int64
+func (_e *IMediaService_Expecter) DeleteMedia(ctx interface{}, mediaId interface{}) *IMediaService_DeleteMedia_Call {
+	return &IMediaService_DeleteMedia_Call{Call: _e.mock.On("DeleteMedia", ctx, mediaId)}
+}
+
+func (_c *IMediaService_DeleteMedia_Call) Run(run func(ctx context.Context, mediaId int64)) *IMediaService_DeleteMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *IMediaService_DeleteMedia_Call) Return(_a0 error) *IMediaService_DeleteMedia_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMediaService_DeleteMedia_Call) RunAndReturn(run func(context.Context, int64) error) *IMediaService_DeleteMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMedia provides a mock function with given fields: mediaId
+func (_m *IMediaService) GetMedia(mediaId int64) (models.Media, error) {
+	ret := _m.Called(mediaId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMedia")
+	}
+
+	var r0 models.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (models.Media, error)); ok {
+		return rf(mediaId)
+	}
+	if rf, ok := ret.Get(0).(func(int64) models.Media); ok {
+		r0 = rf(mediaId)
+	} else {
+		r0 = ret.Get(0).(models.Media)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(mediaId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMediaService_GetMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMedia'
+type IMediaService_GetMedia_Call struct {
+	*mock.Call
+}
+
+// GetMedia is a helper method to define mock.On call
+//   - mediaId int64
+func (_e *IMediaService_Expecter) GetMedia(mediaId interface{}) *IMediaService_GetMedia_Call {
+	return &IMediaService_GetMedia_Call{Call: _e.mock.On("GetMedia", mediaId)}
+}
+
+func (_c *IMediaService_GetMedia_Call) Run(run func(mediaId int64)) *IMediaService_GetMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *IMediaService_GetMedia_Call) Return(_a0 models.Media, _a1 error) *IMediaService_GetMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMediaService_GetMedia_Call) RunAndReturn(run func(int64) (models.Media, error)) *IMediaService_GetMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenMediaFile provides a mock function with given fields: ctx, mediaId, size
+func (_m *IMediaService) OpenMediaFile(ctx context.Context, mediaId int64, size int) (models.MediaFile, error) {
+	ret := _m.Called(ctx, mediaId, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenMediaFile")
+	}
+
+	var r0 models.MediaFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) (models.MediaFile, error)); ok {
+		return rf(ctx, mediaId, size)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) models.MediaFile); ok {
+		r0 = rf(ctx, mediaId, size)
+	} else {
+		r0 = ret.Get(0).(models.MediaFile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, mediaId, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMediaService_OpenMediaFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenMediaFile'
+type IMediaService_OpenMediaFile_Call struct {
+	*mock.Call
+}
+
+// OpenMediaFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mediaId int64
+//   - size int
+func (_e *IMediaService_Expecter) OpenMediaFile(ctx interface{}, mediaId interface{}, size interface{}) *IMediaService_OpenMediaFile_Call {
+	return &IMediaService_OpenMediaFile_Call{Call: _e.mock.On("OpenMediaFile", ctx, mediaId, size)}
+}
+
+func (_c *IMediaService_OpenMediaFile_Call) Run(run func(ctx context.Context, mediaId int64, size int)) *IMediaService_OpenMediaFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IMediaService_OpenMediaFile_Call) Return(_a0 models.MediaFile, _a1 error) *IMediaService_OpenMediaFile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMediaService_OpenMediaFile_Call) RunAndReturn(run func(context.Context, int64, int) (models.MediaFile, error)) *IMediaService_OpenMediaFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadMedia provides a mock function with given fields: ctx, fileName, data
+func (_m *IMediaService) UploadMedia(ctx context.Context, fileName string, data []byte) (models.Media, error) {
+	ret := _m.Called(ctx, fileName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadMedia")
+	}
+
+	var r0 models.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) (models.Media, error)); ok {
+		return rf(ctx, fileName, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) models.Media); ok {
+		r0 = rf(ctx, fileName, data)
+	} else {
+		r0 = ret.Get(0).(models.Media)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, fileName, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMediaService_UploadMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadMedia'
+type IMediaService_UploadMedia_Call struct {
+	*mock.Call
+}
+
+// UploadMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fileName string
+//   - data []byte
+func (_e *IMediaService_Expecter) UploadMedia(ctx interface{}, fileName interface{}, data interface{}) *IMediaService_UploadMedia_Call {
+	return &IMediaService_UploadMedia_Call{Call: _e.mock.On("UploadMedia", ctx, fileName, data)}
+}
+
+func (_c *IMediaService_UploadMedia_Call) Run(run func(ctx context.Context, fileName string, data []byte)) *IMediaService_UploadMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *IMediaService_UploadMedia_Call) Return(_a0 models.Media, _a1 error) *IMediaService_UploadMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMediaService_UploadMedia_Call) RunAndReturn(run func(context.Context, string, []byte) (models.Media, error)) *IMediaService_UploadMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIMediaService creates a new instance of IMediaService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIMediaService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IMediaService {
+	mock := &IMediaService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	models "service/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
@@ -21,6 +22,167 @@ func (_m *INewsService) EXPECT() *INewsService_Expecter {
 	return &INewsService_Expecter{mock: &_m.Mock}
 }
 
+// AddCategories provides a mock function with given fields: newsId, categoryIDs, editor
+func (_m *INewsService) AddCategories(newsId int64, categoryIDs []int64, editor string) (models.NewsWithCategories, error) {
+	ret := _m.Called(newsId, categoryIDs, editor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCategories")
+	}
+
+	var r0 models.NewsWithCategories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, []int64, string) (models.NewsWithCategories, error)); ok {
+		return rf(newsId, categoryIDs, editor)
+	}
+	if rf, ok := ret.Get(0).(func(int64, []int64, string) models.NewsWithCategories); ok {
+		r0 = rf(newsId, categoryIDs, editor)
+	} else {
+		r0 = ret.Get(0).(models.NewsWithCategories)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, []int64, string) error); ok {
+		r1 = rf(newsId, categoryIDs, editor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_AddCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCategories'
+type INewsService_AddCategories_Call struct {
+	*mock.Call
+}
+
+// AddCategories is a helper method to define mock.On call
+//   - newsId int64
+//   - categoryIDs []int64
+//   - editor string
+func (_e *INewsService_Expecter) AddCategories(newsId interface{}, categoryIDs interface{}, editor interface{}) *INewsService_AddCategories_Call {
+	return &INewsService_AddCategories_Call{Call: _e.mock.On("AddCategories", newsId, categoryIDs, editor)}
+}
+
+func (_c *INewsService_AddCategories_Call) Run(run func(newsId int64, categoryIDs []int64, editor string)) *INewsService_AddCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].([]int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *INewsService_AddCategories_Call) Return(_a0 models.NewsWithCategories, _a1 error) *INewsService_AddCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_AddCategories_Call) RunAndReturn(run func(int64, []int64, string) (models.NewsWithCategories, error)) *INewsService_AddCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Archive provides a mock function with given fields: newsId
+func (_m *INewsService) Archive(newsId int64) error {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(newsId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsService_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type INewsService_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsService_Expecter) Archive(newsId interface{}) *INewsService_Archive_Call {
+	return &INewsService_Archive_Call{Call: _e.mock.On("Archive", newsId)}
+}
+
+func (_c *INewsService_Archive_Call) Run(run func(newsId int64)) *INewsService_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_Archive_Call) Return(_a0 error) *INewsService_Archive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsService_Archive_Call) RunAndReturn(run func(int64) error) *INewsService_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkEditNews provides a mock function with given fields: editForm, filter
+func (_m *INewsService) BulkEditNews(editForm models.NewsBulkEditForm, filter models.NewsFilter) (int64, error) {
+	ret := _m.Called(editForm, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkEditNews")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.NewsBulkEditForm, models.NewsFilter) (int64, error)); ok {
+		return rf(editForm, filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.NewsBulkEditForm, models.NewsFilter) int64); ok {
+		r0 = rf(editForm, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.NewsBulkEditForm, models.NewsFilter) error); ok {
+		r1 = rf(editForm, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_BulkEditNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkEditNews'
+type INewsService_BulkEditNews_Call struct {
+	*mock.Call
+}
+
+// BulkEditNews is a helper method to define mock.On call
+//   - editForm models.NewsBulkEditForm
+//   - filter models.NewsFilter
+func (_e *INewsService_Expecter) BulkEditNews(editForm interface{}, filter interface{}) *INewsService_BulkEditNews_Call {
+	return &INewsService_BulkEditNews_Call{Call: _e.mock.On("BulkEditNews", editForm, filter)}
+}
+
+func (_c *INewsService_BulkEditNews_Call) Run(run func(editForm models.NewsBulkEditForm, filter models.NewsFilter)) *INewsService_BulkEditNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.NewsBulkEditForm), args[1].(models.NewsFilter))
+	})
+	return _c
+}
+
+func (_c *INewsService_BulkEditNews_Call) Return(_a0 int64, _a1 error) *INewsService_BulkEditNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_BulkEditNews_Call) RunAndReturn(run func(models.NewsBulkEditForm, models.NewsFilter) (int64, error)) *INewsService_BulkEditNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateNews provides a mock function with given fields: createForm
 func (_m *INewsService) CreateNews(createForm models.NewsCreateForm) (int64, error) {
 	ret := _m.Called(createForm)
@@ -77,6 +239,218 @@ func (_c *INewsService_CreateNews_Call) RunAndReturn(run func(models.NewsCreateF
 	return _c
 }
 
+// CreateNewsBulk provides a mock function with given fields: createForms, rejected, editor, atomic
+func (_m *INewsService) CreateNewsBulk(createForms []models.NewsCreateForm, rejected int, editor string, atomic bool) ([]models.BulkItemResult, error) {
+	ret := _m.Called(createForms, rejected, editor, atomic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNewsBulk")
+	}
+
+	var r0 []models.BulkItemResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.NewsCreateForm, int, string, bool) ([]models.BulkItemResult, error)); ok {
+		return rf(createForms, rejected, editor, atomic)
+	}
+	if rf, ok := ret.Get(0).(func([]models.NewsCreateForm, int, string, bool) []models.BulkItemResult); ok {
+		r0 = rf(createForms, rejected, editor, atomic)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BulkItemResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.NewsCreateForm, int, string, bool) error); ok {
+		r1 = rf(createForms, rejected, editor, atomic)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_CreateNewsBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNewsBulk'
+type INewsService_CreateNewsBulk_Call struct {
+	*mock.Call
+}
+
+// CreateNewsBulk is a helper method to define mock.On call
+//   - createForms []models.NewsCreateForm
+//   - rejected int
+//   - editor string
+//   - atomic bool
+func (_e *INewsService_Expecter) CreateNewsBulk(createForms interface{}, rejected interface{}, editor interface{}, atomic interface{}) *INewsService_CreateNewsBulk_Call {
+	return &INewsService_CreateNewsBulk_Call{Call: _e.mock.On("CreateNewsBulk", createForms, rejected, editor, atomic)}
+}
+
+func (_c *INewsService_CreateNewsBulk_Call) Run(run func(createForms []models.NewsCreateForm, rejected int, editor string, atomic bool)) *INewsService_CreateNewsBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.NewsCreateForm), args[1].(int), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *INewsService_CreateNewsBulk_Call) Return(_a0 []models.BulkItemResult, _a1 error) *INewsService_CreateNewsBulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_CreateNewsBulk_Call) RunAndReturn(run func([]models.NewsCreateForm, int, string, bool) ([]models.BulkItemResult, error)) *INewsService_CreateNewsBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNews provides a mock function with given fields: newsId
+func (_m *INewsService) DeleteNews(newsId int64) error {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(newsId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsService_DeleteNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNews'
+type INewsService_DeleteNews_Call struct {
+	*mock.Call
+}
+
+// DeleteNews is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsService_Expecter) DeleteNews(newsId interface{}) *INewsService_DeleteNews_Call {
+	return &INewsService_DeleteNews_Call{Call: _e.mock.On("DeleteNews", newsId)}
+}
+
+func (_c *INewsService_DeleteNews_Call) Run(run func(newsId int64)) *INewsService_DeleteNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_DeleteNews_Call) Return(_a0 error) *INewsService_DeleteNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsService_DeleteNews_Call) RunAndReturn(run func(int64) error) *INewsService_DeleteNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTranslation provides a mock function with given fields: newsId, locale
+func (_m *INewsService) DeleteTranslation(newsId int64, locale string) error {
+	ret := _m.Called(newsId, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTranslation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, string) error); ok {
+		r0 = rf(newsId, locale)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsService_DeleteTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTranslation'
+type INewsService_DeleteTranslation_Call struct {
+	*mock.Call
+}
+
+// DeleteTranslation is a helper method to define mock.On call
+//   - newsId int64
+//   - locale string
+func (_e *INewsService_Expecter) DeleteTranslation(newsId interface{}, locale interface{}) *INewsService_DeleteTranslation_Call {
+	return &INewsService_DeleteTranslation_Call{Call: _e.mock.On("DeleteTranslation", newsId, locale)}
+}
+
+func (_c *INewsService_DeleteTranslation_Call) Run(run func(newsId int64, locale string)) *INewsService_DeleteTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *INewsService_DeleteTranslation_Call) Return(_a0 error) *INewsService_DeleteTranslation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsService_DeleteTranslation_Call) RunAndReturn(run func(int64, string) error) *INewsService_DeleteTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiffRevisions provides a mock function with given fields: newsId, from, to
+func (_m *INewsService) DiffRevisions(newsId int64, from int64, to int64) (models.NewsRevisionDiff, error) {
+	ret := _m.Called(newsId, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffRevisions")
+	}
+
+	var r0 models.NewsRevisionDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) (models.NewsRevisionDiff, error)); ok {
+		return rf(newsId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) models.NewsRevisionDiff); ok {
+		r0 = rf(newsId, from, to)
+	} else {
+		r0 = ret.Get(0).(models.NewsRevisionDiff)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, int64) error); ok {
+		r1 = rf(newsId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_DiffRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffRevisions'
+type INewsService_DiffRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffRevisions is a helper method to define mock.On call
+//   - newsId int64
+//   - from int64
+//   - to int64
+func (_e *INewsService_Expecter) DiffRevisions(newsId interface{}, from interface{}, to interface{}) *INewsService_DiffRevisions_Call {
+	return &INewsService_DiffRevisions_Call{Call: _e.mock.On("DiffRevisions", newsId, from, to)}
+}
+
+func (_c *INewsService_DiffRevisions_Call) Run(run func(newsId int64, from int64, to int64)) *INewsService_DiffRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_DiffRevisions_Call) Return(_a0 models.NewsRevisionDiff, _a1 error) *INewsService_DiffRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_DiffRevisions_Call) RunAndReturn(run func(int64, int64, int64) (models.NewsRevisionDiff, error)) *INewsService_DiffRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EditNews provides a mock function with given fields: newsId, editForm
 func (_m *INewsService) EditNews(newsId int64, editForm models.NewsEditForm) error {
 	ret := _m.Called(newsId, editForm)
@@ -124,61 +498,1083 @@ func (_c *INewsService_EditNews_Call) RunAndReturn(run func(int64, models.NewsEd
 	return _c
 }
 
-// ListNews provides a mock function with given fields: limit, offset
-func (_m *INewsService) ListNews(limit int64, offset int64) ([]models.NewsWithCategories, error) {
-	ret := _m.Called(limit, offset)
+// ExportNews provides a mock function with given fields: ctx, filter, fn
+func (_m *INewsService) ExportNews(ctx context.Context, filter models.NewsFilter, fn func(models.NewsWithCategories) error) error {
+	ret := _m.Called(ctx, filter, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListNews")
-	}
-
-	var r0 []models.NewsWithCategories
-	var r1 error
-	if rf, ok := ret.Get(0).(func(int64, int64) ([]models.NewsWithCategories, error)); ok {
-		return rf(limit, offset)
-	}
-	if rf, ok := ret.Get(0).(func(int64, int64) []models.NewsWithCategories); ok {
-		r0 = rf(limit, offset)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.NewsWithCategories)
-		}
+		panic("no return value specified for ExportNews")
 	}
 
-	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
-		r1 = rf(limit, offset)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.NewsFilter, func(models.NewsWithCategories) error) error); ok {
+		r0 = rf(ctx, filter, fn)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// INewsService_ListNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNews'
-type INewsService_ListNews_Call struct {
+// INewsService_ExportNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportNews'
+type INewsService_ExportNews_Call struct {
 	*mock.Call
 }
 
-// ListNews is a helper method to define mock.On call
+// ExportNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.NewsFilter
+//   - fn func(models.NewsWithCategories) error
+func (_e *INewsService_Expecter) ExportNews(ctx interface{}, filter interface{}, fn interface{}) *INewsService_ExportNews_Call {
+	return &INewsService_ExportNews_Call{Call: _e.mock.On("ExportNews", ctx, filter, fn)}
+}
+
+func (_c *INewsService_ExportNews_Call) Run(run func(ctx context.Context, filter models.NewsFilter, fn func(models.NewsWithCategories) error)) *INewsService_ExportNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.NewsFilter), args[2].(func(models.NewsWithCategories) error))
+	})
+	return _c
+}
+
+func (_c *INewsService_ExportNews_Call) Return(_a0 error) *INewsService_ExportNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsService_ExportNews_Call) RunAndReturn(run func(context.Context, models.NewsFilter, func(models.NewsWithCategories) error) error) *INewsService_ExportNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Feed provides a mock function with given fields: categoryId, limit
+func (_m *INewsService) Feed(categoryId *int64, limit int64) (models.Feed, error) {
+	ret := _m.Called(categoryId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Feed")
+	}
+
+	var r0 models.Feed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*int64, int64) (models.Feed, error)); ok {
+		return rf(categoryId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(*int64, int64) models.Feed); ok {
+		r0 = rf(categoryId, limit)
+	} else {
+		r0 = ret.Get(0).(models.Feed)
+	}
+
+	if rf, ok := ret.Get(1).(func(*int64, int64) error); ok {
+		r1 = rf(categoryId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_Feed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Feed'
+type INewsService_Feed_Call struct {
+	*mock.Call
+}
+
+// Feed is a helper method to define mock.On call
+//   - categoryId *int64
+//   - limit int64
+func (_e *INewsService_Expecter) Feed(categoryId interface{}, limit interface{}) *INewsService_Feed_Call {
+	return &INewsService_Feed_Call{Call: _e.mock.On("Feed", categoryId, limit)}
+}
+
+func (_c *INewsService_Feed_Call) Run(run func(categoryId *int64, limit int64)) *INewsService_Feed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_Feed_Call) Return(_a0 models.Feed, _a1 error) *INewsService_Feed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_Feed_Call) RunAndReturn(run func(*int64, int64) (models.Feed, error)) *INewsService_Feed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNewsByID provides a mock function with given fields: newsId
+func (_m *INewsService) GetNewsByID(newsId int64) (models.NewsWithCategories, error) {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNewsByID")
+	}
+
+	var r0 models.NewsWithCategories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (models.NewsWithCategories, error)); ok {
+		return rf(newsId)
+	}
+	if rf, ok := ret.Get(0).(func(int64) models.NewsWithCategories); ok {
+		r0 = rf(newsId)
+	} else {
+		r0 = ret.Get(0).(models.NewsWithCategories)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(newsId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_GetNewsByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewsByID'
+type INewsService_GetNewsByID_Call struct {
+	*mock.Call
+}
+
+// GetNewsByID is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsService_Expecter) GetNewsByID(newsId interface{}) *INewsService_GetNewsByID_Call {
+	return &INewsService_GetNewsByID_Call{Call: _e.mock.On("GetNewsByID", newsId)}
+}
+
+func (_c *INewsService_GetNewsByID_Call) Run(run func(newsId int64)) *INewsService_GetNewsByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_GetNewsByID_Call) Return(_a0 models.NewsWithCategories, _a1 error) *INewsService_GetNewsByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_GetNewsByID_Call) RunAndReturn(run func(int64) (models.NewsWithCategories, error)) *INewsService_GetNewsByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevision provides a mock function with given fields: newsId, revision
+func (_m *INewsService) GetRevision(newsId int64, revision int64) (models.NewsRevision, error) {
+	ret := _m.Called(newsId, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 models.NewsRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) (models.NewsRevision, error)); ok {
+		return rf(newsId, revision)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) models.NewsRevision); ok {
+		r0 = rf(newsId, revision)
+	} else {
+		r0 = ret.Get(0).(models.NewsRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(newsId, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type INewsService_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - newsId int64
+//   - revision int64
+func (_e *INewsService_Expecter) GetRevision(newsId interface{}, revision interface{}) *INewsService_GetRevision_Call {
+	return &INewsService_GetRevision_Call{Call: _e.mock.On("GetRevision", newsId, revision)}
+}
+
+func (_c *INewsService_GetRevision_Call) Run(run func(newsId int64, revision int64)) *INewsService_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_GetRevision_Call) Return(_a0 models.NewsRevision, _a1 error) *INewsService_GetRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_GetRevision_Call) RunAndReturn(run func(int64, int64) (models.NewsRevision, error)) *INewsService_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportNews provides a mock function with given fields: createForms, editor, dryRun
+func (_m *INewsService) ImportNews(createForms []models.NewsCreateForm, editor string, dryRun bool) ([]models.BulkItemResult, error) {
+	ret := _m.Called(createForms, editor, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportNews")
+	}
+
+	var r0 []models.BulkItemResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.NewsCreateForm, string, bool) ([]models.BulkItemResult, error)); ok {
+		return rf(createForms, editor, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func([]models.NewsCreateForm, string, bool) []models.BulkItemResult); ok {
+		r0 = rf(createForms, editor, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BulkItemResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.NewsCreateForm, string, bool) error); ok {
+		r1 = rf(createForms, editor, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_ImportNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportNews'
+type INewsService_ImportNews_Call struct {
+	*mock.Call
+}
+
+// ImportNews is a helper method to define mock.On call
+//   - createForms []models.NewsCreateForm
+//   - editor string
+//   - dryRun bool
+func (_e *INewsService_Expecter) ImportNews(createForms interface{}, editor interface{}, dryRun interface{}) *INewsService_ImportNews_Call {
+	return &INewsService_ImportNews_Call{Call: _e.mock.On("ImportNews", createForms, editor, dryRun)}
+}
+
+func (_c *INewsService_ImportNews_Call) Run(run func(createForms []models.NewsCreateForm, editor string, dryRun bool)) *INewsService_ImportNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.NewsCreateForm), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *INewsService_ImportNews_Call) Return(_a0 []models.BulkItemResult, _a1 error) *INewsService_ImportNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_ImportNews_Call) RunAndReturn(run func([]models.NewsCreateForm, string, bool) ([]models.BulkItemResult, error)) *INewsService_ImportNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNews provides a mock function with given fields: limit, offset, cursor, count, filter, sort
+func (_m *INewsService) ListNews(limit int64, offset int64, cursor *models.NewsCursor, count string, filter models.NewsFilter, sort models.NewsSort) (models.NewsPage, error) {
+	ret := _m.Called(limit, offset, cursor, count, filter, sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNews")
+	}
+
+	var r0 models.NewsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.NewsCursor, string, models.NewsFilter, models.NewsSort) (models.NewsPage, error)); ok {
+		return rf(limit, offset, cursor, count, filter, sort)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64, *models.NewsCursor, string, models.NewsFilter, models.NewsSort) models.NewsPage); ok {
+		r0 = rf(limit, offset, cursor, count, filter, sort)
+	} else {
+		r0 = ret.Get(0).(models.NewsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, *models.NewsCursor, string, models.NewsFilter, models.NewsSort) error); ok {
+		r1 = rf(limit, offset, cursor, count, filter, sort)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_ListNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNews'
+type INewsService_ListNews_Call struct {
+	*mock.Call
+}
+
+// ListNews is a helper method to define mock.On call
+//   - limit int64
+//   - offset int64
+//   - cursor *models.NewsCursor
+//   - count string
+//   - filter models.NewsFilter
+//   - sort models.NewsSort
+func (_e *INewsService_Expecter) ListNews(limit interface{}, offset interface{}, cursor interface{}, count interface{}, filter interface{}, sort interface{}) *INewsService_ListNews_Call {
+	return &INewsService_ListNews_Call{Call: _e.mock.On("ListNews", limit, offset, cursor, count, filter, sort)}
+}
+
+func (_c *INewsService_ListNews_Call) Run(run func(limit int64, offset int64, cursor *models.NewsCursor, count string, filter models.NewsFilter, sort models.NewsSort)) *INewsService_ListNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].(*models.NewsCursor), args[3].(string), args[4].(models.NewsFilter), args[5].(models.NewsSort))
+	})
+	return _c
+}
+
+func (_c *INewsService_ListNews_Call) Return(_a0 models.NewsPage, _a1 error) *INewsService_ListNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_ListNews_Call) RunAndReturn(run func(int64, int64, *models.NewsCursor, string, models.NewsFilter, models.NewsSort) (models.NewsPage, error)) *INewsService_ListNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRevisions provides a mock function with given fields: newsId
+func (_m *INewsService) ListRevisions(newsId int64) ([]models.NewsRevision, error) {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevisions")
+	}
+
+	var r0 []models.NewsRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]models.NewsRevision, error)); ok {
+		return rf(newsId)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []models.NewsRevision); ok {
+		r0 = rf(newsId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NewsRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(newsId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type INewsService_ListRevisions_Call struct {
+	*mock.Call
+}
+
+// ListRevisions is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsService_Expecter) ListRevisions(newsId interface{}) *INewsService_ListRevisions_Call {
+	return &INewsService_ListRevisions_Call{Call: _e.mock.On("ListRevisions", newsId)}
+}
+
+func (_c *INewsService_ListRevisions_Call) Run(run func(newsId int64)) *INewsService_ListRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_ListRevisions_Call) Return(_a0 []models.NewsRevision, _a1 error) *INewsService_ListRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_ListRevisions_Call) RunAndReturn(run func(int64) ([]models.NewsRevision, error)) *INewsService_ListRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTranslations provides a mock function with given fields: newsId
+func (_m *INewsService) ListTranslations(newsId int64) (models.NewsTranslations, error) {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTranslations")
+	}
+
+	var r0 models.NewsTranslations
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (models.NewsTranslations, error)); ok {
+		return rf(newsId)
+	}
+	if rf, ok := ret.Get(0).(func(int64) models.NewsTranslations); ok {
+		r0 = rf(newsId)
+	} else {
+		r0 = ret.Get(0).(models.NewsTranslations)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(newsId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_ListTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTranslations'
+type INewsService_ListTranslations_Call struct {
+	*mock.Call
+}
+
+// ListTranslations is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsService_Expecter) ListTranslations(newsId interface{}) *INewsService_ListTranslations_Call {
+	return &INewsService_ListTranslations_Call{Call: _e.mock.On("ListTranslations", newsId)}
+}
+
+func (_c *INewsService_ListTranslations_Call) Run(run func(newsId int64)) *INewsService_ListTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_ListTranslations_Call) Return(_a0 models.NewsTranslations, _a1 error) *INewsService_ListTranslations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_ListTranslations_Call) RunAndReturn(run func(int64) (models.NewsTranslations, error)) *INewsService_ListTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrash provides a mock function with given fields: limit, offset
+func (_m *INewsService) ListTrash(limit int64, offset int64) (models.NewsPage, error) {
+	ret := _m.Called(limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 models.NewsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) (models.NewsPage, error)); ok {
+		return rf(limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) models.NewsPage); ok {
+		r0 = rf(limit, offset)
+	} else {
+		r0 = ret.Get(0).(models.NewsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_ListTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrash'
+type INewsService_ListTrash_Call struct {
+	*mock.Call
+}
+
+// ListTrash is a helper method to define mock.On call
+//   - limit int64
+//   - offset int64
+func (_e *INewsService_Expecter) ListTrash(limit interface{}, offset interface{}) *INewsService_ListTrash_Call {
+	return &INewsService_ListTrash_Call{Call: _e.mock.On("ListTrash", limit, offset)}
+}
+
+func (_c *INewsService_ListTrash_Call) Run(run func(limit int64, offset int64)) *INewsService_ListTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_ListTrash_Call) Return(_a0 models.NewsPage, _a1 error) *INewsService_ListTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_ListTrash_Call) RunAndReturn(run func(int64, int64) (models.NewsPage, error)) *INewsService_ListTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalizeNews provides a mock function with given fields: locale, news
+func (_m *INewsService) LocalizeNews(locale string, news []models.NewsWithCategories) error {
+	ret := _m.Called(locale, news)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalizeNews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []models.NewsWithCategories) error); ok {
+		r0 = rf(locale, news)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsService_LocalizeNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalizeNews'
+type INewsService_LocalizeNews_Call struct {
+	*mock.Call
+}
+
+// LocalizeNews is a helper method to define mock.On call
+//   - locale string
+//   - news []models.NewsWithCategories
+func (_e *INewsService_Expecter) LocalizeNews(locale interface{}, news interface{}) *INewsService_LocalizeNews_Call {
+	return &INewsService_LocalizeNews_Call{Call: _e.mock.On("LocalizeNews", locale, news)}
+}
+
+func (_c *INewsService_LocalizeNews_Call) Run(run func(locale string, news []models.NewsWithCategories)) *INewsService_LocalizeNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]models.NewsWithCategories))
+	})
+	return _c
+}
+
+func (_c *INewsService_LocalizeNews_Call) Return(_a0 error) *INewsService_LocalizeNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsService_LocalizeNews_Call) RunAndReturn(run func(string, []models.NewsWithCategories) error) *INewsService_LocalizeNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function with given fields: newsId
+func (_m *INewsService) Publish(newsId int64) error {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(newsId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsService_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type INewsService_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsService_Expecter) Publish(newsId interface{}) *INewsService_Publish_Call {
+	return &INewsService_Publish_Call{Call: _e.mock.On("Publish", newsId)}
+}
+
+func (_c *INewsService_Publish_Call) Run(run func(newsId int64)) *INewsService_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_Publish_Call) Return(_a0 error) *INewsService_Publish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsService_Publish_Call) RunAndReturn(run func(int64) error) *INewsService_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTrash provides a mock function with no fields
+func (_m *INewsService) PurgeTrash() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrash")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_PurgeTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTrash'
+type INewsService_PurgeTrash_Call struct {
+	*mock.Call
+}
+
+// PurgeTrash is a helper method to define mock.On call
+func (_e *INewsService_Expecter) PurgeTrash() *INewsService_PurgeTrash_Call {
+	return &INewsService_PurgeTrash_Call{Call: _e.mock.On("PurgeTrash")}
+}
+
+func (_c *INewsService_PurgeTrash_Call) Run(run func()) *INewsService_PurgeTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *INewsService_PurgeTrash_Call) Return(_a0 int64, _a1 error) *INewsService_PurgeTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_PurgeTrash_Call) RunAndReturn(run func() (int64, error)) *INewsService_PurgeTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RelatedNews provides a mock function with given fields: newsId, limit
+func (_m *INewsService) RelatedNews(newsId int64, limit int64) ([]models.RelatedNews, error) {
+	ret := _m.Called(newsId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RelatedNews")
+	}
+
+	var r0 []models.RelatedNews
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) ([]models.RelatedNews, error)); ok {
+		return rf(newsId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) []models.RelatedNews); ok {
+		r0 = rf(newsId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RelatedNews)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(newsId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_RelatedNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RelatedNews'
+type INewsService_RelatedNews_Call struct {
+	*mock.Call
+}
+
+// RelatedNews is a helper method to define mock.On call
+//   - newsId int64
 //   - limit int64
-//   - offset int64
-func (_e *INewsService_Expecter) ListNews(limit interface{}, offset interface{}) *INewsService_ListNews_Call {
-	return &INewsService_ListNews_Call{Call: _e.mock.On("ListNews", limit, offset)}
+func (_e *INewsService_Expecter) RelatedNews(newsId interface{}, limit interface{}) *INewsService_RelatedNews_Call {
+	return &INewsService_RelatedNews_Call{Call: _e.mock.On("RelatedNews", newsId, limit)}
 }
 
-func (_c *INewsService_ListNews_Call) Run(run func(limit int64, offset int64)) *INewsService_ListNews_Call {
+func (_c *INewsService_RelatedNews_Call) Run(run func(newsId int64, limit int64)) *INewsService_RelatedNews_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(int64), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *INewsService_ListNews_Call) Return(_a0 []models.NewsWithCategories, _a1 error) *INewsService_ListNews_Call {
+func (_c *INewsService_RelatedNews_Call) Return(_a0 []models.RelatedNews, _a1 error) *INewsService_RelatedNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_RelatedNews_Call) RunAndReturn(run func(int64, int64) ([]models.RelatedNews, error)) *INewsService_RelatedNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCategory provides a mock function with given fields: newsId, categoryId, editor
+func (_m *INewsService) RemoveCategory(newsId int64, categoryId int64, editor string) (models.NewsWithCategories, error) {
+	ret := _m.Called(newsId, categoryId, editor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCategory")
+	}
+
+	var r0 models.NewsWithCategories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64, string) (models.NewsWithCategories, error)); ok {
+		return rf(newsId, categoryId, editor)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64, string) models.NewsWithCategories); ok {
+		r0 = rf(newsId, categoryId, editor)
+	} else {
+		r0 = ret.Get(0).(models.NewsWithCategories)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, string) error); ok {
+		r1 = rf(newsId, categoryId, editor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_RemoveCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCategory'
+type INewsService_RemoveCategory_Call struct {
+	*mock.Call
+}
+
+// RemoveCategory is a helper method to define mock.On call
+//   - newsId int64
+//   - categoryId int64
+//   - editor string
+func (_e *INewsService_Expecter) RemoveCategory(newsId interface{}, categoryId interface{}, editor interface{}) *INewsService_RemoveCategory_Call {
+	return &INewsService_RemoveCategory_Call{Call: _e.mock.On("RemoveCategory", newsId, categoryId, editor)}
+}
+
+func (_c *INewsService_RemoveCategory_Call) Run(run func(newsId int64, categoryId int64, editor string)) *INewsService_RemoveCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *INewsService_RemoveCategory_Call) Return(_a0 models.NewsWithCategories, _a1 error) *INewsService_RemoveCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_RemoveCategory_Call) RunAndReturn(run func(int64, int64, string) (models.NewsWithCategories, error)) *INewsService_RemoveCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreNews provides a mock function with given fields: newsId
+func (_m *INewsService) RestoreNews(newsId int64) error {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreNews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(newsId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsService_RestoreNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreNews'
+type INewsService_RestoreNews_Call struct {
+	*mock.Call
+}
+
+// RestoreNews is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsService_Expecter) RestoreNews(newsId interface{}) *INewsService_RestoreNews_Call {
+	return &INewsService_RestoreNews_Call{Call: _e.mock.On("RestoreNews", newsId)}
+}
+
+func (_c *INewsService_RestoreNews_Call) Run(run func(newsId int64)) *INewsService_RestoreNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_RestoreNews_Call) Return(_a0 error) *INewsService_RestoreNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsService_RestoreNews_Call) RunAndReturn(run func(int64) error) *INewsService_RestoreNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreRevision provides a mock function with given fields: newsId, revision, editor
+func (_m *INewsService) RestoreRevision(newsId int64, revision int64, editor string) error {
+	ret := _m.Called(newsId, revision, editor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, string) error); ok {
+		r0 = rf(newsId, revision, editor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsService_RestoreRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreRevision'
+type INewsService_RestoreRevision_Call struct {
+	*mock.Call
+}
+
+// RestoreRevision is a helper method to define mock.On call
+//   - newsId int64
+//   - revision int64
+//   - editor string
+func (_e *INewsService_Expecter) RestoreRevision(newsId interface{}, revision interface{}, editor interface{}) *INewsService_RestoreRevision_Call {
+	return &INewsService_RestoreRevision_Call{Call: _e.mock.On("RestoreRevision", newsId, revision, editor)}
+}
+
+func (_c *INewsService_RestoreRevision_Call) Run(run func(newsId int64, revision int64, editor string)) *INewsService_RestoreRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *INewsService_RestoreRevision_Call) Return(_a0 error) *INewsService_RestoreRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsService_RestoreRevision_Call) RunAndReturn(run func(int64, int64, string) error) *INewsService_RestoreRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReturnToDraft provides a mock function with given fields: newsId
+func (_m *INewsService) ReturnToDraft(newsId int64) error {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReturnToDraft")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(newsId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsService_ReturnToDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReturnToDraft'
+type INewsService_ReturnToDraft_Call struct {
+	*mock.Call
+}
+
+// ReturnToDraft is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsService_Expecter) ReturnToDraft(newsId interface{}) *INewsService_ReturnToDraft_Call {
+	return &INewsService_ReturnToDraft_Call{Call: _e.mock.On("ReturnToDraft", newsId)}
+}
+
+func (_c *INewsService_ReturnToDraft_Call) Run(run func(newsId int64)) *INewsService_ReturnToDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_ReturnToDraft_Call) Return(_a0 error) *INewsService_ReturnToDraft_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsService_ReturnToDraft_Call) RunAndReturn(run func(int64) error) *INewsService_ReturnToDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchNews provides a mock function with given fields: query, limit, offset, filter, sort
+func (_m *INewsService) SearchNews(query string, limit int64, offset int64, filter models.NewsFilter, sort models.NewsSort) ([]models.NewsSearchResult, error) {
+	ret := _m.Called(query, limit, offset, filter, sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchNews")
+	}
+
+	var r0 []models.NewsSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64, models.NewsFilter, models.NewsSort) ([]models.NewsSearchResult, error)); ok {
+		return rf(query, limit, offset, filter, sort)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64, models.NewsFilter, models.NewsSort) []models.NewsSearchResult); ok {
+		r0 = rf(query, limit, offset, filter, sort)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NewsSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64, models.NewsFilter, models.NewsSort) error); ok {
+		r1 = rf(query, limit, offset, filter, sort)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_SearchNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchNews'
+type INewsService_SearchNews_Call struct {
+	*mock.Call
+}
+
+// SearchNews is a helper method to define mock.On call
+//   - query string
+//   - limit int64
+//   - offset int64
+//   - filter models.NewsFilter
+//   - sort models.NewsSort
+func (_e *INewsService_Expecter) SearchNews(query interface{}, limit interface{}, offset interface{}, filter interface{}, sort interface{}) *INewsService_SearchNews_Call {
+	return &INewsService_SearchNews_Call{Call: _e.mock.On("SearchNews", query, limit, offset, filter, sort)}
+}
+
+func (_c *INewsService_SearchNews_Call) Run(run func(query string, limit int64, offset int64, filter models.NewsFilter, sort models.NewsSort)) *INewsService_SearchNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(int64), args[3].(models.NewsFilter), args[4].(models.NewsSort))
+	})
+	return _c
+}
+
+func (_c *INewsService_SearchNews_Call) Return(_a0 []models.NewsSearchResult, _a1 error) *INewsService_SearchNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *INewsService_SearchNews_Call) RunAndReturn(run func(string, int64, int64, models.NewsFilter, models.NewsSort) ([]models.NewsSearchResult, error)) *INewsService_SearchNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitForReview provides a mock function with given fields: newsId
+func (_m *INewsService) SubmitForReview(newsId int64) error {
+	ret := _m.Called(newsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitForReview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(newsId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// INewsService_SubmitForReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitForReview'
+type INewsService_SubmitForReview_Call struct {
+	*mock.Call
+}
+
+// SubmitForReview is a helper method to define mock.On call
+//   - newsId int64
+func (_e *INewsService_Expecter) SubmitForReview(newsId interface{}) *INewsService_SubmitForReview_Call {
+	return &INewsService_SubmitForReview_Call{Call: _e.mock.On("SubmitForReview", newsId)}
+}
+
+func (_c *INewsService_SubmitForReview_Call) Run(run func(newsId int64)) *INewsService_SubmitForReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *INewsService_SubmitForReview_Call) Return(_a0 error) *INewsService_SubmitForReview_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *INewsService_SubmitForReview_Call) RunAndReturn(run func(int64) error) *INewsService_SubmitForReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TranslationCompleteness provides a mock function with given fields: filter
+func (_m *INewsService) TranslationCompleteness(filter models.NewsFilter) ([]models.TranslationCompleteness, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TranslationCompleteness")
+	}
+
+	var r0 []models.TranslationCompleteness
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.NewsFilter) ([]models.TranslationCompleteness, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.NewsFilter) []models.TranslationCompleteness); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TranslationCompleteness)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.NewsFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// INewsService_TranslationCompleteness_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TranslationCompleteness'
+type INewsService_TranslationCompleteness_Call struct {
+	*mock.Call
+}
+
+// TranslationCompleteness is a helper method to define mock.On call
+//   - filter models.NewsFilter
+func (_e *INewsService_Expecter) TranslationCompleteness(filter interface{}) *INewsService_TranslationCompleteness_Call {
+	return &INewsService_TranslationCompleteness_Call{Call: _e.mock.On("TranslationCompleteness", filter)}
+}
+
+func (_c *INewsService_TranslationCompleteness_Call) Run(run func(filter models.NewsFilter)) *INewsService_TranslationCompleteness_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.NewsFilter))
+	})
+	return _c
+}
+
+func (_c *INewsService_TranslationCompleteness_Call) Return(_a0 []models.TranslationCompleteness, _a1 error) *INewsService_TranslationCompleteness_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *INewsService_ListNews_Call) RunAndReturn(run func(int64, int64) ([]models.NewsWithCategories, error)) *INewsService_ListNews_Call {
+func (_c *INewsService_TranslationCompleteness_Call) RunAndReturn(run func(models.NewsFilter) ([]models.TranslationCompleteness, error)) *INewsService_TranslationCompleteness_Call {
 	_c.Call.Return(run)
 	return _c
 }
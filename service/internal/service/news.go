@@ -1,34 +1,196 @@
 package service
 
 import (
+	"context"
+	"fmt"
+	"service/internal/apperrors"
+	"service/internal/configs"
 	"service/internal/models"
 	"service/internal/repository"
+	"service/pkg/clock"
+	"service/pkg/diff"
 	"service/pkg/logger"
+	"service/pkg/markup"
+	"slices"
+	"strings"
+	"time"
 )
 
 //go:generate mockery --name=INewsService --output=mocks --outpkg=mocks --case=snake --with-expecter
 type INewsService interface {
 	CreateNews(createForm models.NewsCreateForm) (int64, error)
+	CreateNewsBulk(createForms []models.NewsCreateForm, rejected int, editor string, atomic bool) ([]models.BulkItemResult, error)
+	ImportNews(createForms []models.NewsCreateForm, editor string, dryRun bool) ([]models.BulkItemResult, error)
 	EditNews(newsId int64, editForm models.NewsEditForm) error
-	ListNews(limit, offset int64) ([]models.NewsWithCategories, error)
+	ListNews(limit, offset int64, cursor *models.NewsCursor, count string, filter models.NewsFilter, sort models.NewsSort) (models.NewsPage, error)
+	GetNewsByID(newsId int64) (models.NewsWithCategories, error)
+	SearchNews(query string, limit, offset int64, filter models.NewsFilter, sort models.NewsSort) ([]models.NewsSearchResult, error)
+	RelatedNews(newsId, limit int64) ([]models.RelatedNews, error)
+	DeleteNews(newsId int64) error
+	ListTrash(limit, offset int64) (models.NewsPage, error)
+	RestoreNews(newsId int64) error
+	PurgeTrash() (int64, error)
+	SubmitForReview(newsId int64) error
+	Publish(newsId int64) error
+	Archive(newsId int64) error
+	ReturnToDraft(newsId int64) error
+	BulkEditNews(editForm models.NewsBulkEditForm, filter models.NewsFilter) (int64, error)
+	AddCategories(newsId int64, categoryIDs []int64, editor string) (models.NewsWithCategories, error)
+	RemoveCategory(newsId, categoryId int64, editor string) (models.NewsWithCategories, error)
+	ExportNews(ctx context.Context, filter models.NewsFilter, fn func(models.NewsWithCategories) error) error
+	Feed(categoryId *int64, limit int64) (models.Feed, error)
+	ListRevisions(newsId int64) ([]models.NewsRevision, error)
+	GetRevision(newsId, revision int64) (models.NewsRevision, error)
+	DiffRevisions(newsId, from, to int64) (models.NewsRevisionDiff, error)
+	RestoreRevision(newsId, revision int64, editor string) error
+	LocalizeNews(locale string, news []models.NewsWithCategories) error
+	ListTranslations(newsId int64) (models.NewsTranslations, error)
+	DeleteTranslation(newsId int64, locale string) error
+	TranslationCompleteness(filter models.NewsFilter) ([]models.TranslationCompleteness, error)
 }
+
+// NewsObserver is notified after changes to news are committed, e.g. to render sitemaps again.
+// No IDs means that any news may have changed.
+type NewsObserver interface {
+	NewsChanged(ids ...int64)
+}
+
+// atomicRejectedMessage is the error of valid news that was not created because its atomic batch was rejected.
+const atomicRejectedMessage = "not created: batch rejected (atomic)"
+
+// newsTransitions lists the statuses news can move to from each status.
+var newsTransitions = map[string][]string{
+	models.NewsStatusDraft:     {models.NewsStatusReview},
+	models.NewsStatusReview:    {models.NewsStatusPublished, models.NewsStatusDraft},
+	models.NewsStatusPublished: {models.NewsStatusArchived, models.NewsStatusDraft},
+	models.NewsStatusArchived:  {models.NewsStatusDraft},
+}
+
 type NewsService struct {
-	repo repository.INewsRepository
-	log  *logger.Logger
+	repo         repository.INewsRepository
+	categoryRepo repository.ICategoryRepository
+	clock        clock.Clock
+	log          *logger.Logger
+	config       configs.News
+	observer     NewsObserver
 }
 
-func NewNewsService(repo repository.INewsRepository, log *logger.Logger) INewsService {
+// NewNewsService creates the news service; observer may be nil.
+func NewNewsService(repo repository.INewsRepository, categoryRepo repository.ICategoryRepository, clock clock.Clock, log *logger.Logger, config configs.News, observer NewsObserver) INewsService {
 	return &NewsService{
-		repo: repo,
-		log:  log,
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		clock:        clock,
+		log:          log,
+		config:       config,
+		observer:     observer,
 	}
 }
 
 func (s *NewsService) CreateNews(createForm models.NewsCreateForm) (int64, error) {
-	return s.repo.CreateNews(createForm)
+	if createForm.Categories != nil {
+		if err := s.checkCategoriesExist(*createForm.Categories); err != nil {
+			return 0, err
+		}
+	}
+
+	createForm.Content = sanitizeContent(createForm.Content, createForm.ContentFormat)
+
+	id, err := s.repo.CreateNews(createForm)
+	if err != nil {
+		return 0, err
+	}
+
+	s.notify(id)
+	return id, nil
+}
+
+// CreateNewsBulk creates news in one batch and reports a result for each form, in input order.
+// News with unknown categories is skipped, or rejects the whole batch when atomic is set; so do the rejected
+// items of the request that never reached the service. The valid news of a rejected batch gets atomicRejectedMessage.
+func (s *NewsService) CreateNewsBulk(createForms []models.NewsCreateForm, rejected int, editor string, atomic bool) ([]models.BulkItemResult, error) {
+	return s.createNewsBatch(createForms, rejected, editor, atomic, false)
 }
 
+// ImportNews creates one batch of imported news like CreateNewsBulk, skipping news with unknown categories.
+// With dryRun nothing is written and the results only tell which news would be rejected.
+func (s *NewsService) ImportNews(createForms []models.NewsCreateForm, editor string, dryRun bool) ([]models.BulkItemResult, error) {
+	return s.createNewsBatch(createForms, 0, editor, false, dryRun)
+}
+
+func (s *NewsService) createNewsBatch(createForms []models.NewsCreateForm, rejected int, editor string, atomic, dryRun bool) ([]models.BulkItemResult, error) {
+	categoryIDs := make([]int64, 0)
+	for _, form := range createForms {
+		if form.Categories != nil {
+			categoryIDs = append(categoryIDs, *form.Categories...)
+		}
+	}
+	slices.Sort(categoryIDs)
+
+	var missing []int64
+	if len(categoryIDs) > 0 {
+		var err error
+		if missing, err = s.categoryRepo.FindMissingIDs(slices.Compact(categoryIDs)); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]models.BulkItemResult, len(createForms))
+	valid := make([]models.NewsCreateForm, 0, len(createForms))
+	positions := make([]int, 0, len(createForms))
+	for i, form := range createForms {
+		results[i].Index = i
+
+		if form.Categories != nil {
+			unknown := slices.DeleteFunc(slices.Clone(*form.Categories), func(id int64) bool {
+				return !slices.Contains(missing, id)
+			})
+			if len(unknown) > 0 {
+				results[i].Error = unknownCategoriesMessage(unknown)
+				continue
+			}
+		}
+
+		form.Content = sanitizeContent(form.Content, form.ContentFormat)
+		valid = append(valid, form)
+		positions = append(positions, i)
+	}
+
+	if atomic && (rejected > 0 || len(valid) < len(createForms)) {
+		for _, i := range positions {
+			results[i].Error = atomicRejectedMessage
+		}
+		return results, nil
+	}
+
+	if len(valid) == 0 || dryRun {
+		return results, nil
+	}
+
+	ids, err := s.repo.CreateNewsBulk(valid, editor)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		results[positions[i]].Id = &id
+	}
+	s.notify(ids...)
+
+	return results, nil
+}
+
+// EditNews edits news, or only its translation to editForm.Locale when that is not the default locale.
 func (s *NewsService) EditNews(newsId int64, editForm models.NewsEditForm) error {
+	if editForm.Locale != "" {
+		if err := s.checkLocale(editForm.Locale); err != nil {
+			return err
+		}
+		if editForm.Locale != s.config.DefaultLocale {
+			return s.editTranslation(newsId, editForm)
+		}
+	}
+
 	updateFields := make(map[string]interface{})
 	if editForm.Title != nil {
 		updateFields["title"] = *editForm.Title
@@ -36,20 +198,433 @@ func (s *NewsService) EditNews(newsId int64, editForm models.NewsEditForm) error
 	if editForm.Content != nil {
 		updateFields["content"] = *editForm.Content
 	}
+	// The schedule is set as *time.Time, nil removing it.
+	if editForm.PublishAt != nil || editForm.ClearPublishAt {
+		updateFields["publish_at"] = editForm.PublishAt
+	}
+	if editForm.ExpiresAt != nil || editForm.ClearExpiresAt {
+		updateFields["expires_at"] = editForm.ExpiresAt
+	}
+	if editForm.ContentFormat != nil {
+		updateFields["content_format"] = *editForm.ContentFormat
+	}
+
+	if err := s.sanitizeEditedContent(newsId, editForm, updateFields); err != nil {
+		return err
+	}
+
+	if editForm.Categories != nil {
+		if err := s.checkCategoriesExist(*editForm.Categories); err != nil {
+			return err
+		}
+	}
+
+	if len(updateFields) > 0 || editForm.Categories != nil || editForm.Media != nil {
+		if err := s.repo.UpdateNews(newsId, updateFields, editForm.Categories, editForm.Media, editForm.Version, editForm.Editor); err != nil {
+			return err
+		}
+		s.notify(newsId)
+	}
+	return nil
+}
+
+// sanitizeEditedContent sanitises the content of news that is HTML after the edit. When the form leaves out
+// the content or its format, the current one is read, so content sent alone and news switched to HTML are both covered.
+func (s *NewsService) sanitizeEditedContent(newsId int64, editForm models.NewsEditForm, updateFields map[string]interface{}) error {
+	content, format := editForm.Content, editForm.ContentFormat
+	if content == nil && (format == nil || *format != models.ContentFormatHTML) {
+		return nil
+	}
 
-	if len(updateFields) > 0 || editForm.Categories != nil {
-		if err := s.repo.UpdateNews(newsId, updateFields, editForm.Categories); err != nil {
+	if content == nil || format == nil {
+		news, err := s.repo.GetNewsByID(newsId)
+		if err != nil {
 			return err
 		}
+		if content == nil {
+			content = &news.Content
+		}
+		if format == nil {
+			format = &news.ContentFormat
+		}
+	}
+
+	if *format == models.ContentFormatHTML {
+		updateFields["content"] = markup.Sanitize(*content)
+	}
+	return nil
+}
+
+// ListNews returns a page of news selected by offset, or by cursor when it is set.
+// The total is counted as requested by count, one of models.CountModes, exact by default.
+func (s *NewsService) ListNews(limit, offset int64, cursor *models.NewsCursor, count string, filter models.NewsFilter, sort models.NewsSort) (models.NewsPage, error) {
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []string{models.NewsStatusPublished}
+	}
+
+	if len(sort) == 0 {
+		sort = models.DefaultNewsSort
+	}
+
+	// One extra row tells whether there is more news past the page.
+	newsList, err := s.repo.GetNews(limit+1, offset, cursor, filter, sort)
+	if err != nil {
+		return models.NewsPage{News: []models.NewsWithCategories{}}, err
+	}
+
+	backward := cursor != nil && cursor.Backward
+	hasMore := int64(len(newsList)) > limit
+	if hasMore {
+		if backward {
+			newsList = newsList[1:]
+		} else {
+			newsList = newsList[:limit]
+		}
+	}
+
+	page := models.NewsPage{News: newsList}
+	if page.Total, err = s.countNews(count, filter); err != nil {
+		return models.NewsPage{News: []models.NewsWithCategories{}}, err
+	}
+
+	if len(newsList) == 0 {
+		return page, nil
+	}
+
+	hasNext, hasPrev := hasMore, cursor != nil || offset > 0
+	if backward {
+		hasNext, hasPrev = true, hasMore
+	}
+	page.HasMore = hasNext
+
+	if hasNext {
+		next := models.NewsCursorAt(newsList[len(newsList)-1].News, sort, false)
+		page.NextCursor = &next
+	}
+
+	if hasPrev {
+		prev := models.NewsCursorAt(newsList[0].News, sort, true)
+		page.PrevCursor = &prev
+	}
+
+	return page, nil
+}
+
+func (s *NewsService) countNews(count string, filter models.NewsFilter) (*int64, error) {
+	var total int64
+	var err error
+
+	switch count {
+	case models.CountNone:
+		return nil, nil
+	case models.CountEstimated:
+		total, err = s.repo.EstimateNews(filter)
+	default:
+		total, err = s.repo.CountNews(filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &total, nil
+}
+
+func (s *NewsService) SearchNews(query string, limit, offset int64, filter models.NewsFilter, sort models.NewsSort) ([]models.NewsSearchResult, error) {
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []string{models.NewsStatusPublished}
+	}
+
+	if len(sort) == 0 {
+		sort = models.DefaultSearchSort
+	}
+
+	results, err := s.repo.SearchNews(query, limit, offset, filter, sort)
+	if err != nil {
+		return []models.NewsSearchResult{}, err
+	}
+
+	return results, nil
+}
+
+// RelatedNews returns the published news most related to news, scored with the configured weights.
+// Zero limit returns the configured number of news.
+func (s *NewsService) RelatedNews(newsId, limit int64) ([]models.RelatedNews, error) {
+	if limit == 0 {
+		limit = s.config.RelatedLimit
+	}
+
+	return s.repo.GetRelatedNews(newsId, limit, models.RelatedWeights{
+		Category:     s.config.RelatedCategoryWeight,
+		Title:        s.config.RelatedTitleWeight,
+		Recency:      s.config.RelatedRecencyWeight,
+		HalfLifeDays: s.config.RelatedHalfLifeDays,
+	})
+}
+
+// ExportNews passes the news matching filter to fn in ID order. Like ListNews, it exports published news unless statuses are given.
+func (s *NewsService) ExportNews(ctx context.Context, filter models.NewsFilter, fn func(models.NewsWithCategories) error) error {
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []string{models.NewsStatusPublished}
+	}
+
+	return s.repo.ExportNews(ctx, filter, fn)
+}
+
+// Feed returns the limit most recently published news, of a category and its subcategories when categoryId is set.
+func (s *NewsService) Feed(categoryId *int64, limit int64) (models.Feed, error) {
+	filter := models.NewsFilter{Statuses: []string{models.NewsStatusPublished}}
+
+	var feed models.Feed
+	if categoryId != nil {
+		category, err := s.categoryRepo.GetCategoryByID(*categoryId)
+		if err != nil {
+			return feed, err
+		}
+		feed.Category = &category
+
+		filter.CategoryIDs = []int64{*categoryId}
+		filter.IncludeDescendants = true
+	}
+
+	sort := models.NewsSort{{Field: models.NewsSortPublishedAt, Desc: true}}
+
+	var err error
+	if feed.News, err = s.repo.GetNews(limit, 0, nil, filter, sort); err != nil {
+		return feed, err
+	}
+
+	categories, err := s.categoryRepo.GetAllCategories()
+	if err != nil {
+		return feed, err
+	}
+
+	feed.Categories = make(map[int64]models.Category, len(categories))
+	for _, category := range categories {
+		feed.Categories[category.ID] = category
+	}
+
+	return feed, nil
+}
+
+func (s *NewsService) GetNewsByID(newsId int64) (models.NewsWithCategories, error) {
+	return s.repo.GetNewsByID(newsId)
+}
+
+func (s *NewsService) DeleteNews(newsId int64) error {
+	if err := s.repo.DeleteNews(newsId); err != nil {
+		return err
+	}
+
+	s.notify(newsId)
+	return nil
+}
+
+func (s *NewsService) ListTrash(limit, offset int64) (models.NewsPage, error) {
+	newsList, err := s.repo.GetTrash(limit+1, offset)
+	if err != nil {
+		return models.NewsPage{News: []models.NewsWithCategories{}}, err
+	}
+
+	hasMore := int64(len(newsList)) > limit
+	if hasMore {
+		newsList = newsList[:limit]
+	}
+
+	return models.NewsPage{News: newsList, HasMore: hasMore}, nil
+}
+
+func (s *NewsService) RestoreNews(newsId int64) error {
+	if err := s.repo.RestoreNews(newsId); err != nil {
+		return err
+	}
+
+	s.notify(newsId)
+	return nil
+}
+
+func (s *NewsService) PurgeTrash() (int64, error) {
+	retention := time.Duration(s.config.TrashRetentionDays) * 24 * time.Hour
+
+	return s.repo.PurgeNews(s.clock.Now().Add(-retention))
+}
+
+func (s *NewsService) SubmitForReview(newsId int64) error {
+	return s.changeStatus(newsId, models.NewsStatusReview)
+}
+
+func (s *NewsService) Publish(newsId int64) error {
+	return s.changeStatus(newsId, models.NewsStatusPublished)
+}
+
+func (s *NewsService) Archive(newsId int64) error {
+	return s.changeStatus(newsId, models.NewsStatusArchived)
+}
+
+func (s *NewsService) ReturnToDraft(newsId int64) error {
+	return s.changeStatus(newsId, models.NewsStatusDraft)
+}
+
+// BulkEditNews applies one operation to the news selected by editForm.Ids or filter and returns the number of news changed.
+// Added categories must exist; set_status follows the same transitions as the single news endpoints.
+func (s *NewsService) BulkEditNews(editForm models.NewsBulkEditForm, filter models.NewsFilter) (int64, error) {
+	var allowedFrom []string
+	switch editForm.Operation {
+	case models.BulkAddCategories:
+		if err := s.checkCategoriesExist(editForm.Categories); err != nil {
+			return 0, err
+		}
+	case models.BulkReplaceCategory:
+		if err := s.checkCategoriesExist([]int64{editForm.To}); err != nil {
+			return 0, err
+		}
+	case models.BulkSetStatus:
+		allowedFrom = allowedSourceStatuses(editForm.Status)
+	}
+
+	affected, err := s.repo.BulkEditNews(editForm, filter, allowedFrom)
+	if err != nil {
+		return 0, err
+	}
+
+	// News selected by a filter is not known here, so without Ids any news is reported as changed.
+	if affected > 0 {
+		s.notify(editForm.Ids...)
+	}
+	return affected, nil
+}
+
+func (s *NewsService) AddCategories(newsId int64, categoryIDs []int64, editor string) (models.NewsWithCategories, error) {
+	if err := s.checkCategoriesExist(categoryIDs); err != nil {
+		return models.NewsWithCategories{}, err
 	}
+
+	news, err := s.repo.AddNewsCategories(newsId, categoryIDs, editor)
+	if err != nil {
+		return models.NewsWithCategories{}, err
+	}
+
+	s.notify(newsId)
+	return news, nil
+}
+
+func (s *NewsService) RemoveCategory(newsId, categoryId int64, editor string) (models.NewsWithCategories, error) {
+	news, err := s.repo.RemoveNewsCategory(newsId, categoryId, editor)
+	if err != nil {
+		return models.NewsWithCategories{}, err
+	}
+
+	s.notify(newsId)
+	return news, nil
+}
+
+func (s *NewsService) ListRevisions(newsId int64) ([]models.NewsRevision, error) {
+	return s.repo.GetRevisions(newsId)
+}
+
+func (s *NewsService) GetRevision(newsId, revision int64) (models.NewsRevision, error) {
+	return s.repo.GetRevision(newsId, revision)
+}
+
+func (s *NewsService) DiffRevisions(newsId, from, to int64) (models.NewsRevisionDiff, error) {
+	fromRevision, err := s.repo.GetRevision(newsId, from)
+	if err != nil {
+		return models.NewsRevisionDiff{}, err
+	}
+
+	toRevision, err := s.repo.GetRevision(newsId, to)
+	if err != nil {
+		return models.NewsRevisionDiff{}, err
+	}
+
+	return models.NewsRevisionDiff{
+		NewsId: newsId,
+		From:   from,
+		To:     to,
+		Lines:  diff.Lines(fromRevision.Content, toRevision.Content),
+	}, nil
+}
+
+// RestoreRevision rolls news back to an earlier revision by saving its snapshot as a new revision.
+func (s *NewsService) RestoreRevision(newsId, revision int64, editor string) error {
+	rev, err := s.repo.GetRevision(newsId, revision)
+	if err != nil {
+		return err
+	}
+
+	if err = s.checkCategoriesExist(rev.Categories); err != nil {
+		return err
+	}
+
+	updateFields := map[string]interface{}{
+		"title":   rev.Title,
+		"content": rev.Content,
+	}
+
+	if err = s.repo.UpdateNews(newsId, updateFields, &rev.Categories, nil, nil, editor); err != nil {
+		return err
+	}
+
+	s.notify(newsId)
+	return nil
+}
+
+func (s *NewsService) changeStatus(newsId int64, status string) error {
+	if err := s.repo.UpdateNewsStatus(newsId, allowedSourceStatuses(status), status); err != nil {
+		return err
+	}
+
+	s.notify(newsId)
 	return nil
 }
 
-func (s *NewsService) ListNews(limit, offset int64) ([]models.NewsWithCategories, error) {
-	newsList, err := s.repo.GetNews(limit, offset)
+// notify tells the observer about committed changes to news, to any news when no IDs are given.
+func (s *NewsService) notify(ids ...int64) {
+	if s.observer != nil {
+		s.observer.NewsChanged(ids...)
+	}
+}
+
+// sanitizeContent sanitises HTML content, so only allow-listed markup is saved.
+func sanitizeContent(content, format string) string {
+	if format == models.ContentFormatHTML {
+		return markup.Sanitize(content)
+	}
+	return content
+}
+
+func allowedSourceStatuses(status string) []string {
+	sources := make([]string, 0)
+	for _, from := range models.NewsStatuses {
+		if slices.Contains(newsTransitions[from], status) {
+			sources = append(sources, from)
+		}
+	}
+
+	return sources
+}
+
+func (s *NewsService) checkCategoriesExist(categoryIDs []int64) error {
+	if len(categoryIDs) == 0 {
+		return nil
+	}
+
+	missing, err := s.categoryRepo.FindMissingIDs(categoryIDs)
 	if err != nil {
-		return []models.NewsWithCategories{}, err
+		return err
+	}
+
+	if len(missing) > 0 {
+		return apperrors.NewValidation(unknownCategoriesMessage(missing))
+	}
+
+	return nil
+}
+
+func unknownCategoriesMessage(categoryIDs []int64) string {
+	ids := make([]string, len(categoryIDs))
+	for i, id := range categoryIDs {
+		ids[i] = fmt.Sprint(id)
 	}
 
-	return newsList, nil
+	return "Categories: unknown category IDs: " + strings.Join(ids, ", ")
 }
@@ -1,15 +1,19 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"service/internal/apperrors"
+	"service/internal/configs"
 	"service/internal/models"
 	"service/internal/repository/mocks"
 	customLog "service/pkg/logger"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var testLogger = func() *customLog.Logger {
@@ -19,14 +23,39 @@ var testLogger = func() *customLog.Logger {
 	return &customLog.Logger{Logger: log}
 }()
 
-func setupRepo(t *testing.T) *mocks.INewsRepository {
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+var testClock = &fakeClock{now: time.Date(2025, 12, 20, 12, 0, 0, 0, time.UTC)}
+
+var testConfig = configs.News{
+	TrashRetentionDays: 30,
+	DefaultLocale:      "ru",
+	Locales:            []string{"ru", "en", "de"},
+	FallbackLocales:    []string{"en"},
+
+	RelatedLimit:          5,
+	RelatedCategoryWeight: 0.5,
+	RelatedTitleWeight:    0.3,
+	RelatedRecencyWeight:  0.2,
+	RelatedHalfLifeDays:   30,
+}
+
+func setupRepo(t *testing.T) (*mocks.INewsRepository, *mocks.ICategoryRepository) {
 	mockRepo := new(mocks.INewsRepository)
+	mockCategoryRepo := new(mocks.ICategoryRepository)
 
 	t.Cleanup(func() {
 		mockRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
 	})
 
-	return mockRepo
+	return mockRepo, mockCategoryRepo
 }
 
 func TestCreateNews(t *testing.T) {
@@ -38,10 +67,11 @@ func TestCreateNews(t *testing.T) {
 	var newsId int64 = 1
 
 	t.Run("Success", func(t *testing.T) {
-		mockRepo := setupRepo(t)
+		mockRepo, mockCategoryRepo := setupRepo(t)
 
+		mockCategoryRepo.On("FindMissingIDs", *createForm.Categories).Return([]int64{}, nil)
 		mockRepo.On("CreateNews", createForm).Return(newsId, nil)
-		service := NewNewsService(mockRepo, testLogger)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
 
 		id, err := service.CreateNews(createForm)
 
@@ -49,18 +79,159 @@ func TestCreateNews(t *testing.T) {
 		assert.Equal(t, newsId, id)
 	})
 
+	t.Run("SuccessSanitizesHTML", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		htmlForm := models.NewsCreateForm{
+			Title:         "Title",
+			Content:       `<p>Text</p><script>alert(1)</script>`,
+			ContentFormat: models.ContentFormatHTML,
+		}
+		sanitized := htmlForm
+		sanitized.Content = "<p>Text</p>"
+
+		mockRepo.On("CreateNews", sanitized).Return(newsId, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		_, err := service.CreateNews(htmlForm)
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("Failed", func(t *testing.T) {
-		mockRepo := setupRepo(t)
+		mockRepo, mockCategoryRepo := setupRepo(t)
 		expectedErr := apperrors.NewInternal("internal error")
 
+		mockCategoryRepo.On("FindMissingIDs", *createForm.Categories).Return([]int64{}, nil)
 		mockRepo.On("CreateNews", createForm).Return(int64(0), expectedErr)
-		service := NewNewsService(mockRepo, testLogger)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
 
 		_, actualErr := service.CreateNews(createForm)
 
 		assert.Error(t, actualErr)
 		assert.EqualError(t, actualErr, expectedErr.Error())
 	})
+
+	t.Run("FailedUnknownCategories", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockCategoryRepo.On("FindMissingIDs", *createForm.Categories).Return([]int64{2, 3}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		_, actualErr := service.CreateNews(createForm)
+
+		var appErr *apperrors.AppError
+		assert.ErrorAs(t, actualErr, &appErr)
+		assert.Equal(t, 400, appErr.StatusCode)
+		assert.EqualError(t, actualErr, "Categories: unknown category IDs: 2, 3")
+		mockRepo.AssertNotCalled(t, "CreateNews")
+	})
+}
+
+func TestCreateNewsBulk(t *testing.T) {
+	createForms := []models.NewsCreateForm{
+		{Title: "First", Content: "First content", Categories: &[]int64{2, 1}},
+		{Title: "Second", Content: "Second content", Categories: &[]int64{3}},
+		{Title: "Third", Content: "Third content"},
+	}
+	var firstID, thirdID int64 = 11, 13
+
+	t.Run("SuccessPartial", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockCategoryRepo.On("FindMissingIDs", []int64{1, 2, 3}).Return([]int64{3}, nil)
+		mockRepo.On("CreateNewsBulk", []models.NewsCreateForm{createForms[0], createForms[2]}, "importer").
+			Return([]int64{firstID, thirdID}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		results, err := service.CreateNewsBulk(createForms, 0, "importer", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.BulkItemResult{
+			{Index: 0, Id: &firstID},
+			{Index: 1, Error: "Categories: unknown category IDs: 3"},
+			{Index: 2, Id: &thirdID},
+		}, results)
+	})
+
+	t.Run("FailedAtomic", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockCategoryRepo.On("FindMissingIDs", []int64{1, 2, 3}).Return([]int64{3}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		results, err := service.CreateNewsBulk(createForms, 0, "importer", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.BulkItemResult{
+			{Index: 0, Error: "not created: batch rejected (atomic)"},
+			{Index: 1, Error: "Categories: unknown category IDs: 3"},
+			{Index: 2, Error: "not created: batch rejected (atomic)"},
+		}, results)
+		mockRepo.AssertNotCalled(t, "CreateNewsBulk")
+	})
+
+	t.Run("FailedAtomicRejectedBefore", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		results, err := service.CreateNewsBulk(createForms[2:], 1, "importer", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.BulkItemResult{{Index: 0, Error: "not created: batch rejected (atomic)"}}, results)
+		mockRepo.AssertNotCalled(t, "CreateNewsBulk")
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		expectedErr := apperrors.NewInternal("internal error")
+
+		mockRepo.On("CreateNewsBulk", createForms[2:], "importer").Return(nil, expectedErr)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		_, actualErr := service.CreateNewsBulk(createForms[2:], 0, "importer", false)
+
+		assert.EqualError(t, actualErr, expectedErr.Error())
+	})
+}
+
+func TestImportNews(t *testing.T) {
+	createForms := []models.NewsCreateForm{
+		{Title: "First", Content: "First content", Categories: &[]int64{1}},
+		{Title: "Second", Content: "Second content", Categories: &[]int64{4}},
+	}
+	var firstID int64 = 11
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockCategoryRepo.On("FindMissingIDs", []int64{1, 4}).Return([]int64{4}, nil)
+		mockRepo.On("CreateNewsBulk", createForms[:1], "migration").Return([]int64{firstID}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		results, err := service.ImportNews(createForms, "migration", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.BulkItemResult{
+			{Index: 0, Id: &firstID},
+			{Index: 1, Error: "Categories: unknown category IDs: 4"},
+		}, results)
+	})
+
+	t.Run("SuccessDryRun", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockCategoryRepo.On("FindMissingIDs", []int64{1, 4}).Return([]int64{4}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		results, err := service.ImportNews(createForms, "migration", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.BulkItemResult{
+			{Index: 0},
+			{Index: 1, Error: "Categories: unknown category IDs: 4"},
+		}, results)
+		mockRepo.AssertNotCalled(t, "CreateNewsBulk")
+	})
 }
 
 func TestListNews(t *testing.T) {
@@ -76,32 +247,187 @@ func TestListNews(t *testing.T) {
 		},
 	}
 
+	publishedFilter := models.NewsFilter{Statuses: []string{models.NewsStatusPublished}}
+
 	t.Run("ListNewsSuccess", func(t *testing.T) {
-		mockRepo := setupRepo(t)
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetNews", limit+1, offset, (*models.NewsCursor)(nil), publishedFilter, models.DefaultNewsSort).Return(newsList, nil)
+		mockRepo.On("CountNews", publishedFilter).Return(int64(1), nil)
+
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualPage, actualErr := service.ListNews(limit, offset, nil, models.CountExact, models.NewsFilter{}, nil)
+
+		assert.NoError(t, actualErr)
+		assert.Equal(t, newsList, actualPage.News)
+		assert.Equal(t, int64(1), *actualPage.Total)
+		assert.False(t, actualPage.HasMore)
+		assert.Nil(t, actualPage.NextCursor)
+		assert.Nil(t, actualPage.PrevCursor)
+	})
+
+	t.Run("ListNewsEstimatedTotal", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetNews", limit+1, offset, (*models.NewsCursor)(nil), publishedFilter, models.DefaultNewsSort).Return(newsList, nil)
+		mockRepo.On("EstimateNews", publishedFilter).Return(int64(1200), nil)
+
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
 
-		mockRepo.On("GetNews", limit, offset).Return(newsList, nil)
+		actualPage, actualErr := service.ListNews(limit, offset, nil, models.CountEstimated, models.NewsFilter{}, nil)
 
-		service := NewNewsService(mockRepo, testLogger)
+		assert.NoError(t, actualErr)
+		assert.Equal(t, int64(1200), *actualPage.Total)
+	})
+
+	t.Run("ListNewsWithoutTotal", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetNews", limit+1, offset, (*models.NewsCursor)(nil), publishedFilter, models.DefaultNewsSort).Return(newsList, nil)
 
-		actualNewsList, actualErr := service.ListNews(limit, offset)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualPage, actualErr := service.ListNews(limit, offset, nil, models.CountNone, models.NewsFilter{}, nil)
 
 		assert.NoError(t, actualErr)
-		assert.Equal(t, actualNewsList, newsList)
+		assert.Nil(t, actualPage.Total)
+		mockRepo.AssertNotCalled(t, "CountNews", publishedFilter)
+	})
+
+	t.Run("ListNewsWithStatusFilter", func(t *testing.T) {
+		filter := models.NewsFilter{Statuses: []string{models.NewsStatusDraft, models.NewsStatusReview}}
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetNews", limit+1, offset, (*models.NewsCursor)(nil), filter, models.DefaultNewsSort).Return(newsList, nil)
+
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualPage, actualErr := service.ListNews(limit, offset, nil, models.CountNone, filter, nil)
+
+		assert.NoError(t, actualErr)
+		assert.Equal(t, newsList, actualPage.News)
 	})
 
 	t.Run("ListNewsFailed", func(t *testing.T) {
 		expectedErr := errors.New("database error")
-		mockRepo := setupRepo(t)
+		mockRepo, mockCategoryRepo := setupRepo(t)
 
-		mockRepo.On("GetNews", limit, offset).Return([]models.NewsWithCategories{}, expectedErr)
+		mockRepo.On("GetNews", limit+1, offset, (*models.NewsCursor)(nil), publishedFilter, models.DefaultNewsSort).Return([]models.NewsWithCategories{}, expectedErr)
 
-		service := NewNewsService(mockRepo, testLogger)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
 
-		_, actualErr := service.ListNews(limit, offset)
+		_, actualErr := service.ListNews(limit, offset, nil, models.CountNone, models.NewsFilter{}, nil)
 
 		assert.Error(t, actualErr)
 		assert.EqualError(t, actualErr, expectedErr.Error())
 	})
+
+	pageOf := func(ids ...int64) []models.NewsWithCategories {
+		page := make([]models.NewsWithCategories, len(ids))
+		for i, id := range ids {
+			page[i] = models.NewsWithCategories{News: models.News{ID: id}, Categories: []int64{}}
+		}
+		return page
+	}
+	cursorAt := func(id int64, backward bool) *models.NewsCursor {
+		cursor := models.NewsCursorAt(models.News{ID: id}, models.DefaultNewsSort, backward)
+		return &cursor
+	}
+
+	t.Run("ListNewsFirstPageHasNext", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetNews", int64(3), offset, (*models.NewsCursor)(nil), publishedFilter, models.DefaultNewsSort).Return(pageOf(9, 8, 7), nil)
+
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualPage, actualErr := service.ListNews(2, offset, nil, models.CountNone, models.NewsFilter{}, nil)
+
+		assert.NoError(t, actualErr)
+		assert.Equal(t, pageOf(9, 8), actualPage.News)
+		assert.True(t, actualPage.HasMore)
+		assert.Equal(t, cursorAt(8, false), actualPage.NextCursor)
+		assert.Nil(t, actualPage.PrevCursor)
+	})
+
+	t.Run("ListNewsAfterCursor", func(t *testing.T) {
+		cursor := cursorAt(8, false)
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetNews", int64(3), offset, cursor, publishedFilter, models.DefaultNewsSort).Return(pageOf(7, 6), nil)
+
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualPage, actualErr := service.ListNews(2, offset, cursor, models.CountNone, models.NewsFilter{}, nil)
+
+		assert.NoError(t, actualErr)
+		assert.Equal(t, pageOf(7, 6), actualPage.News)
+		assert.Nil(t, actualPage.NextCursor)
+		assert.Equal(t, cursorAt(7, true), actualPage.PrevCursor)
+	})
+
+	t.Run("ListNewsBeforeCursor", func(t *testing.T) {
+		cursor := cursorAt(6, true)
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetNews", int64(3), offset, cursor, publishedFilter, models.DefaultNewsSort).Return(pageOf(9, 8, 7), nil)
+
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualPage, actualErr := service.ListNews(2, offset, cursor, models.CountNone, models.NewsFilter{}, nil)
+
+		assert.NoError(t, actualErr)
+		assert.Equal(t, pageOf(8, 7), actualPage.News)
+		assert.Equal(t, cursorAt(7, false), actualPage.NextCursor)
+		assert.Equal(t, cursorAt(8, true), actualPage.PrevCursor)
+	})
+}
+
+func TestSearchNews(t *testing.T) {
+	var limit int64 = 10
+	var offset int64 = 0
+	var categoryID int64 = 3
+	results := []models.NewsSearchResult{
+		{
+			NewsWithCategories: models.NewsWithCategories{
+				News:       models.News{ID: 1, Title: "Новости спорта"},
+				Categories: []int64{3},
+			},
+			Rank:           0.6,
+			TitleHighlight: "Новости <b>спорта</b>",
+		},
+	}
+
+	t.Run("SearchNewsSuccess", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("SearchNews", "спорт", limit, offset, models.NewsFilter{
+			CategoryIDs: []int64{categoryID},
+			Statuses:    []string{models.NewsStatusPublished},
+		}, models.DefaultSearchSort).Return(results, nil)
+
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actual, actualErr := service.SearchNews("спорт", limit, offset, models.NewsFilter{CategoryIDs: []int64{categoryID}}, nil)
+
+		assert.NoError(t, actualErr)
+		assert.Equal(t, results, actual)
+	})
+
+	t.Run("SearchNewsFailed", func(t *testing.T) {
+		expectedErr := errors.New("database error")
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("SearchNews", "спорт", limit, offset, models.NewsFilter{Statuses: []string{models.NewsStatusPublished}}, models.DefaultSearchSort).Return(nil, expectedErr)
+
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actual, actualErr := service.SearchNews("спорт", limit, offset, models.NewsFilter{}, nil)
+
+		assert.EqualError(t, actualErr, expectedErr.Error())
+		assert.Empty(t, actual)
+	})
 }
 
 func TestEditNews(t *testing.T) {
@@ -109,6 +435,8 @@ func TestEditNews(t *testing.T) {
 	newTitle := "NewTitle"
 	newContent := "NewContent"
 	newCategories := []int64{1, 2}
+	publishAt := time.Date(2025, 12, 21, 6, 0, 0, 0, time.UTC)
+	expiresAt := publishAt.Add(48 * time.Hour)
 	testDataSuccess := []struct {
 		name                       string
 		editForm                   models.NewsEditForm
@@ -143,6 +471,30 @@ func TestEditNews(t *testing.T) {
 			expectedModifiedFields:     map[string]interface{}{},
 			expectedModifiedCategories: &newCategories,
 		},
+		{
+			name: "update schedule",
+			editForm: models.NewsEditForm{
+				PublishAt: &publishAt,
+				ExpiresAt: &expiresAt,
+			},
+			expectedModifiedFields: map[string]interface{}{
+				"publish_at": &publishAt,
+				"expires_at": &expiresAt,
+			},
+			expectedModifiedCategories: nil,
+		},
+		{
+			name: "clear schedule",
+			editForm: models.NewsEditForm{
+				ClearPublishAt: true,
+				ClearExpiresAt: true,
+			},
+			expectedModifiedFields: map[string]interface{}{
+				"publish_at": (*time.Time)(nil),
+				"expires_at": (*time.Time)(nil),
+			},
+			expectedModifiedCategories: nil,
+		},
 		{
 			name: "update all fields",
 			editForm: models.NewsEditForm{
@@ -160,10 +512,18 @@ func TestEditNews(t *testing.T) {
 
 	for _, tt := range testDataSuccess {
 		t.Run("Success_"+tt.name, func(t *testing.T) {
-			mockRepo := setupRepo(t)
+			mockRepo, mockCategoryRepo := setupRepo(t)
 
-			mockRepo.On("UpdateNews", newsId, tt.expectedModifiedFields, tt.expectedModifiedCategories).Return(nil)
-			service := NewNewsService(mockRepo, testLogger)
+			if tt.expectedModifiedCategories != nil {
+				mockCategoryRepo.On("FindMissingIDs", *tt.expectedModifiedCategories).Return([]int64{}, nil)
+			}
+			if tt.editForm.Content != nil && tt.editForm.ContentFormat == nil {
+				mockRepo.On("GetNewsByID", newsId).Return(models.NewsWithCategories{
+					News: models.News{ID: newsId, Content: "Content", ContentFormat: models.ContentFormatPlain},
+				}, nil)
+			}
+			mockRepo.On("UpdateNews", newsId, tt.expectedModifiedFields, tt.expectedModifiedCategories, (*[]int64)(nil), (*int64)(nil), "").Return(nil)
+			service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
 
 			actualErr := service.EditNews(newsId, tt.editForm)
 
@@ -173,9 +533,9 @@ func TestEditNews(t *testing.T) {
 
 	t.Run("SuccessNoFieldsToUpdate", func(t *testing.T) {
 		editForm := models.NewsEditForm{}
-		mockRepo := setupRepo(t)
+		mockRepo, mockCategoryRepo := setupRepo(t)
 
-		service := NewNewsService(mockRepo, testLogger)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
 
 		actualErr := service.EditNews(newsId, editForm)
 
@@ -183,19 +543,607 @@ func TestEditNews(t *testing.T) {
 		mockRepo.AssertNotCalled(t, "UpdateNews")
 	})
 
+	t.Run("FailedUnknownCategories", func(t *testing.T) {
+		editForm := models.NewsEditForm{
+			Categories: &newCategories,
+		}
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockCategoryRepo.On("FindMissingIDs", newCategories).Return([]int64{2}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualErr := service.EditNews(newsId, editForm)
+
+		assert.ErrorIs(t, actualErr, apperrors.ErrValidation)
+		assert.EqualError(t, actualErr, "Categories: unknown category IDs: 2")
+		mockRepo.AssertNotCalled(t, "UpdateNews")
+	})
+
 	t.Run("Failed", func(t *testing.T) {
 		editForm := models.NewsEditForm{
 			Title: &newTitle,
 		}
 		expectedErr := apperrors.NewNotFound("News not found")
-		mockRepo := setupRepo(t)
+		mockRepo, mockCategoryRepo := setupRepo(t)
 
-		mockRepo.On("UpdateNews", newsId, map[string]interface{}{"title": newTitle}, (*[]int64)(nil)).Return(expectedErr)
-		service := NewNewsService(mockRepo, testLogger)
+		mockRepo.On("UpdateNews", newsId, map[string]interface{}{"title": newTitle}, (*[]int64)(nil), (*[]int64)(nil), (*int64)(nil), "").Return(expectedErr)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
 
 		actualErr := service.EditNews(newsId, editForm)
 
 		assert.Error(t, actualErr)
 		assert.EqualError(t, actualErr, expectedErr.Error())
 	})
+
+	t.Run("FailedStaleVersion", func(t *testing.T) {
+		var version int64 = 2
+		editForm := models.NewsEditForm{
+			Title:   &newTitle,
+			Version: &version,
+			Editor:  "alice",
+		}
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("UpdateNews", newsId, map[string]interface{}{"title": newTitle}, (*[]int64)(nil), (*[]int64)(nil), &version, "alice").
+			Return(apperrors.NewVersionConflict(3))
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualErr := service.EditNews(newsId, editForm)
+
+		assert.ErrorIs(t, actualErr, apperrors.ErrVersionConflict)
+	})
+
+	htmlFormat := models.ContentFormatHTML
+	markdownFormat := models.ContentFormatMarkdown
+	unsafeContent := `<p onclick="alert(1)">Text</p><script>alert(1)</script>`
+	sanitizeData := []struct {
+		name           string
+		editForm       models.NewsEditForm
+		current        *models.News
+		expectedFields map[string]interface{}
+	}{
+		{
+			name:     "html content",
+			editForm: models.NewsEditForm{Content: &unsafeContent, ContentFormat: &htmlFormat},
+			expectedFields: map[string]interface{}{
+				"content":        "<p>Text</p>",
+				"content_format": htmlFormat,
+			},
+		},
+		{
+			name:           "content of html news",
+			editForm:       models.NewsEditForm{Content: &unsafeContent},
+			current:        &models.News{ID: newsId, ContentFormat: models.ContentFormatHTML},
+			expectedFields: map[string]interface{}{"content": "<p>Text</p>"},
+		},
+		{
+			name:     "news switched to html",
+			editForm: models.NewsEditForm{ContentFormat: &htmlFormat},
+			current:  &models.News{ID: newsId, Content: unsafeContent, ContentFormat: models.ContentFormatMarkdown},
+			expectedFields: map[string]interface{}{
+				"content":        "<p>Text</p>",
+				"content_format": htmlFormat,
+			},
+		},
+		{
+			name:     "markdown content is kept",
+			editForm: models.NewsEditForm{Content: &unsafeContent, ContentFormat: &markdownFormat},
+			expectedFields: map[string]interface{}{
+				"content":        unsafeContent,
+				"content_format": markdownFormat,
+			},
+		},
+	}
+
+	for _, tt := range sanitizeData {
+		t.Run("SuccessSanitize_"+tt.name, func(t *testing.T) {
+			mockRepo, mockCategoryRepo := setupRepo(t)
+
+			if tt.current != nil {
+				mockRepo.On("GetNewsByID", newsId).Return(models.NewsWithCategories{News: *tt.current}, nil)
+			}
+			mockRepo.On("UpdateNews", newsId, tt.expectedFields, (*[]int64)(nil), (*[]int64)(nil), (*int64)(nil), "").Return(nil)
+			service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+			assert.NoError(t, service.EditNews(newsId, tt.editForm))
+		})
+	}
+}
+
+func TestExportNews(t *testing.T) {
+	fn := func(models.NewsWithCategories) error { return nil }
+
+	t.Run("SuccessDefaultStatus", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("ExportNews", mock.Anything, models.NewsFilter{Statuses: []string{models.NewsStatusPublished}}, mock.Anything).Return(nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		assert.NoError(t, service.ExportNews(context.Background(), models.NewsFilter{}, fn))
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		filter := models.NewsFilter{Statuses: []string{models.NewsStatusDraft}}
+
+		mockRepo.On("ExportNews", mock.Anything, filter, mock.Anything).Return(context.Canceled)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		assert.ErrorIs(t, service.ExportNews(context.Background(), filter, fn), context.Canceled)
+	})
+}
+
+func TestFeed(t *testing.T) {
+	news := []models.NewsWithCategories{{News: models.News{ID: 1, Title: "News"}, Categories: []int64{1}}}
+	categories := []models.Category{{ID: 1, Name: "Sport"}, {ID: 2, Name: "Football"}}
+	sort := models.NewsSort{{Field: models.NewsSortPublishedAt, Desc: true}}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		filter := models.NewsFilter{Statuses: []string{models.NewsStatusPublished}}
+
+		mockRepo.On("GetNews", int64(20), int64(0), (*models.NewsCursor)(nil), filter, sort).Return(news, nil)
+		mockCategoryRepo.On("GetAllCategories").Return(categories, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		feed, err := service.Feed(nil, 20)
+
+		assert.NoError(t, err)
+		assert.Nil(t, feed.Category)
+		assert.Equal(t, news, feed.News)
+		assert.Equal(t, map[int64]models.Category{1: categories[0], 2: categories[1]}, feed.Categories)
+	})
+
+	t.Run("SuccessCategory", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		categoryId := int64(1)
+		filter := models.NewsFilter{
+			Statuses:           []string{models.NewsStatusPublished},
+			CategoryIDs:        []int64{categoryId},
+			IncludeDescendants: true,
+		}
+
+		mockCategoryRepo.On("GetCategoryByID", categoryId).Return(categories[0], nil)
+		mockRepo.On("GetNews", int64(20), int64(0), (*models.NewsCursor)(nil), filter, sort).Return(news, nil)
+		mockCategoryRepo.On("GetAllCategories").Return(categories, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		feed, err := service.Feed(&categoryId, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &categories[0], feed.Category)
+		assert.Equal(t, news, feed.News)
+	})
+
+	t.Run("CategoryNotFound", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		categoryId := int64(3)
+
+		mockCategoryRepo.On("GetCategoryByID", categoryId).
+			Return(models.Category{}, apperrors.NewCategoryNotFound("Category with id=3 not found"))
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		_, err := service.Feed(&categoryId, 20)
+
+		assert.ErrorIs(t, err, apperrors.ErrCategoryNotFound)
+	})
+}
+
+func TestGetNewsByID(t *testing.T) {
+	var newsId int64 = 5
+	news := models.NewsWithCategories{
+		News: models.News{
+			ID:      newsId,
+			Title:   "News",
+			Content: "All World",
+		},
+		Categories: []int64{1},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetNewsByID", newsId).Return(news, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualNews, actualErr := service.GetNewsByID(newsId)
+
+		assert.NoError(t, actualErr)
+		assert.Equal(t, news, actualNews)
+	})
+
+	t.Run("FailedNotFound", func(t *testing.T) {
+		expectedErr := apperrors.NewNotFound("News not found")
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetNewsByID", newsId).Return(models.NewsWithCategories{}, expectedErr)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		_, actualErr := service.GetNewsByID(newsId)
+
+		assert.ErrorIs(t, actualErr, apperrors.ErrNewsNotFound)
+	})
+}
+
+func TestDeleteAndRestoreNews(t *testing.T) {
+	var newsId int64 = 3
+
+	t.Run("DeleteSuccess", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("DeleteNews", newsId).Return(nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		assert.NoError(t, service.DeleteNews(newsId))
+	})
+
+	t.Run("RestoreFailedNotInTrash", func(t *testing.T) {
+		expectedErr := apperrors.NewNotFound("News not found in trash")
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("RestoreNews", newsId).Return(expectedErr)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualErr := service.RestoreNews(newsId)
+
+		assert.ErrorIs(t, actualErr, apperrors.ErrNewsNotFound)
+	})
+}
+
+func TestListTrash(t *testing.T) {
+	t.Run("HasMore", func(t *testing.T) {
+		trash := []models.NewsWithCategories{{News: models.News{ID: 3}}, {News: models.News{ID: 2}}}
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetTrash", int64(2), int64(0)).Return(trash, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		page, err := service.ListTrash(1, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, trash[:1], page.News)
+		assert.True(t, page.HasMore)
+	})
+}
+
+func TestPurgeTrash(t *testing.T) {
+	t.Run("UsesRetentionPeriod", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		mockRepo.On("PurgeNews", time.Date(2025, 11, 20, 12, 0, 0, 0, time.UTC)).Return(int64(2), nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		purged, actualErr := service.PurgeTrash()
+
+		assert.NoError(t, actualErr)
+		assert.Equal(t, int64(2), purged)
+	})
+}
+
+func TestStatusTransitions(t *testing.T) {
+	var newsId int64 = 8
+
+	testData := []struct {
+		name          string
+		transition    func(service INewsService) error
+		expectedFrom  []string
+		expectedState string
+	}{
+		{
+			name:          "submit for review",
+			transition:    func(service INewsService) error { return service.SubmitForReview(newsId) },
+			expectedFrom:  []string{models.NewsStatusDraft},
+			expectedState: models.NewsStatusReview,
+		},
+		{
+			name:          "publish",
+			transition:    func(service INewsService) error { return service.Publish(newsId) },
+			expectedFrom:  []string{models.NewsStatusReview},
+			expectedState: models.NewsStatusPublished,
+		},
+		{
+			name:          "archive",
+			transition:    func(service INewsService) error { return service.Archive(newsId) },
+			expectedFrom:  []string{models.NewsStatusPublished},
+			expectedState: models.NewsStatusArchived,
+		},
+		{
+			name:       "return to draft",
+			transition: func(service INewsService) error { return service.ReturnToDraft(newsId) },
+			expectedFrom: []string{
+				models.NewsStatusReview,
+				models.NewsStatusPublished,
+				models.NewsStatusArchived,
+			},
+			expectedState: models.NewsStatusDraft,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run("Success_"+tt.name, func(t *testing.T) {
+			mockRepo, mockCategoryRepo := setupRepo(t)
+
+			mockRepo.On("UpdateNewsStatus", newsId, tt.expectedFrom, tt.expectedState).Return(nil)
+			service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+			assert.NoError(t, tt.transition(service))
+		})
+	}
+
+	t.Run("FailedNotAllowed", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("UpdateNewsStatus", newsId, []string{models.NewsStatusReview}, models.NewsStatusPublished).
+			Return(apperrors.NewConflict("Cannot change status from draft to published"))
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actualErr := service.Publish(newsId)
+
+		assert.ErrorIs(t, actualErr, apperrors.ErrConflict)
+	})
+}
+
+func TestBulkEditNews(t *testing.T) {
+	filter := models.NewsFilter{CategoryIDs: []int64{3}}
+
+	t.Run("SuccessSetStatus", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		editForm := models.NewsBulkEditForm{Operation: models.BulkSetStatus, Status: models.NewsStatusArchived}
+
+		mockRepo.On("BulkEditNews", editForm, filter, []string{models.NewsStatusPublished}).Return(int64(4), nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		affected, err := service.BulkEditNews(editForm, filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), affected)
+	})
+
+	t.Run("SuccessReplaceCategory", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		editForm := models.NewsBulkEditForm{Operation: models.BulkReplaceCategory, From: 3, To: 4}
+
+		mockCategoryRepo.On("FindMissingIDs", []int64{4}).Return([]int64{}, nil)
+		mockRepo.On("BulkEditNews", editForm, filter, []string(nil)).Return(int64(2), nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		affected, err := service.BulkEditNews(editForm, filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), affected)
+	})
+
+	t.Run("FailedUnknownCategories", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		editForm := models.NewsBulkEditForm{Ids: []int64{1}, Operation: models.BulkAddCategories, Categories: []int64{5, 6}}
+
+		mockCategoryRepo.On("FindMissingIDs", []int64{5, 6}).Return([]int64{6}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		_, actualErr := service.BulkEditNews(editForm, models.NewsFilter{})
+
+		assert.EqualError(t, actualErr, "Categories: unknown category IDs: 6")
+		mockRepo.AssertNotCalled(t, "BulkEditNews")
+	})
+}
+
+func TestNewsCategories(t *testing.T) {
+	news := models.NewsWithCategories{News: models.News{ID: 1}, Categories: []int64{1, 2}}
+
+	t.Run("SuccessAdd", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockCategoryRepo.On("FindMissingIDs", []int64{2}).Return([]int64{}, nil)
+		mockRepo.On("AddNewsCategories", int64(1), []int64{2}, "editor").Return(news, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actual, err := service.AddCategories(1, []int64{2}, "editor")
+
+		assert.NoError(t, err)
+		assert.Equal(t, news, actual)
+	})
+
+	t.Run("FailedAddUnknownCategories", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockCategoryRepo.On("FindMissingIDs", []int64{2, 7}).Return([]int64{7}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		_, actualErr := service.AddCategories(1, []int64{2, 7}, "editor")
+
+		assert.EqualError(t, actualErr, "Categories: unknown category IDs: 7")
+		mockRepo.AssertNotCalled(t, "AddNewsCategories")
+	})
+
+	t.Run("SuccessRemove", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("RemoveNewsCategory", int64(1), int64(3), "editor").Return(news, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actual, err := service.RemoveCategory(1, 3, "editor")
+
+		assert.NoError(t, err)
+		assert.Equal(t, news, actual)
+	})
+}
+
+func TestDiffRevisions(t *testing.T) {
+	var newsId int64 = 1
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetRevision", newsId, int64(1)).
+			Return(models.NewsRevision{NewsId: newsId, Revision: 1, Content: "first\nsecond"}, nil)
+		mockRepo.On("GetRevision", newsId, int64(2)).
+			Return(models.NewsRevision{NewsId: newsId, Revision: 2, Content: "first\nthird"}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		actual, err := service.DiffRevisions(newsId, 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), actual.From)
+		assert.Equal(t, int64(2), actual.To)
+		assert.Len(t, actual.Lines, 3)
+	})
+
+	t.Run("FailedRevisionNotFound", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetRevision", newsId, int64(1)).
+			Return(models.NewsRevision{}, apperrors.NewRevisionNotFound("Revision not found"))
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		_, err := service.DiffRevisions(newsId, 1, 2)
+
+		assert.ErrorIs(t, err, apperrors.ErrRevisionNotFound)
+	})
+}
+
+func TestRestoreRevision(t *testing.T) {
+	var newsId int64 = 1
+	revision := models.NewsRevision{
+		NewsId:     newsId,
+		Revision:   2,
+		Title:      "Old title",
+		Content:    "Old content",
+		Categories: []int64{1, 2},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetRevision", newsId, int64(2)).Return(revision, nil)
+		mockCategoryRepo.On("FindMissingIDs", []int64{1, 2}).Return([]int64{}, nil)
+		mockRepo.On("UpdateNews", newsId,
+			map[string]interface{}{"title": "Old title", "content": "Old content"},
+			&[]int64{1, 2}, (*[]int64)(nil), (*int64)(nil), "editor").Return(nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		assert.NoError(t, service.RestoreRevision(newsId, 2, "editor"))
+	})
+
+	t.Run("FailedCategoryDeleted", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+
+		mockRepo.On("GetRevision", newsId, int64(2)).Return(revision, nil)
+		mockCategoryRepo.On("FindMissingIDs", []int64{1, 2}).Return([]int64{2}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		err := service.RestoreRevision(newsId, 2, "editor")
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		mockRepo.AssertNotCalled(t, "UpdateNews")
+	})
+}
+
+type recordingObserver struct {
+	calls [][]int64
+}
+
+func (o *recordingObserver) NewsChanged(ids ...int64) {
+	o.calls = append(o.calls, ids)
+}
+
+func TestNewsObserver(t *testing.T) {
+	t.Run("NotifiedAfterCreate", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		observer := &recordingObserver{}
+		createForm := models.NewsCreateForm{Title: "Title", Content: "Content"}
+
+		mockRepo.On("CreateNews", createForm).Return(int64(7), nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, observer)
+
+		_, err := service.CreateNews(createForm)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]int64{{7}}, observer.calls)
+	})
+
+	t.Run("NotifiedAfterEdit", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		observer := &recordingObserver{}
+		title := "Title"
+
+		mockRepo.On("UpdateNews", int64(7), map[string]interface{}{"title": title}, (*[]int64)(nil), (*[]int64)(nil), (*int64)(nil), "").Return(nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, observer)
+
+		assert.NoError(t, service.EditNews(7, models.NewsEditForm{Title: &title}))
+		assert.Equal(t, [][]int64{{7}}, observer.calls)
+	})
+
+	t.Run("NotifiedAfterStatusChange", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		observer := &recordingObserver{}
+
+		mockRepo.On("UpdateNewsStatus", int64(7), []string{models.NewsStatusPublished}, models.NewsStatusArchived).Return(nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, observer)
+
+		assert.NoError(t, service.Archive(7))
+		assert.Equal(t, [][]int64{{7}}, observer.calls)
+	})
+
+	t.Run("NotifiedAboutAnyNewsAfterBulkEditByFilter", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		observer := &recordingObserver{}
+		editForm := models.NewsBulkEditForm{Operation: models.BulkSetStatus, Status: models.NewsStatusArchived}
+		filter := models.NewsFilter{CategoryIDs: []int64{3}}
+
+		mockRepo.On("BulkEditNews", editForm, filter, []string{models.NewsStatusPublished}).Return(int64(4), nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, observer)
+
+		_, err := service.BulkEditNews(editForm, filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]int64{nil}, observer.calls)
+	})
+
+	t.Run("NotNotifiedOnError", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		observer := &recordingObserver{}
+
+		mockRepo.On("DeleteNews", int64(7)).Return(apperrors.NewNotFound("News not found"))
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, observer)
+
+		assert.Error(t, service.DeleteNews(7))
+		assert.Empty(t, observer.calls)
+	})
+}
+
+func TestRelatedNews(t *testing.T) {
+	var newsId int64 = 10
+	weights := models.RelatedWeights{Category: 0.5, Title: 0.3, Recency: 0.2, HalfLifeDays: 30}
+	related := []models.RelatedNews{{NewsWithCategories: models.NewsWithCategories{News: models.News{ID: 11}}, Score: 0.8}}
+
+	t.Run("SuccessConfiguredLimit", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		mockRepo.On("GetRelatedNews", newsId, int64(5), weights).Return(related, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		result, err := service.RelatedNews(newsId, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, related, result)
+	})
+
+	t.Run("SuccessRequestedLimit", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		mockRepo.On("GetRelatedNews", newsId, int64(20), weights).Return(related, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		result, err := service.RelatedNews(newsId, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, related, result)
+	})
+
+	t.Run("FailedNotFound", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		mockRepo.On("GetRelatedNews", newsId, int64(5), weights).Return(nil, apperrors.NewNotFound("News not found"))
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		_, err := service.RelatedNews(newsId, 0)
+
+		assert.ErrorIs(t, err, apperrors.ErrNewsNotFound)
+	})
 }
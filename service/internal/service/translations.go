@@ -0,0 +1,204 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"service/internal/apperrors"
+	"service/internal/models"
+	"slices"
+	"strings"
+)
+
+// LocalizeNews replaces the title and content of news with their translation to locale. News that is not
+// translated to it is shown in the first fallback locale it is translated to, or as written, in the default locale.
+func (s *NewsService) LocalizeNews(locale string, news []models.NewsWithCategories) error {
+	if err := s.checkLocale(locale); err != nil {
+		return err
+	}
+
+	chain := s.localeChain(locale)
+
+	translated := make(map[int64]map[string]models.NewsTranslation)
+	if len(chain) > 0 && len(news) > 0 {
+		ids := make([]int64, len(news))
+		for i, item := range news {
+			ids[i] = item.ID
+		}
+
+		translations, err := s.repo.GetTranslations(ids, chain)
+		if err != nil {
+			return err
+		}
+
+		for _, translation := range translations {
+			if translated[translation.NewsId] == nil {
+				translated[translation.NewsId] = make(map[string]models.NewsTranslation)
+			}
+			translated[translation.NewsId][translation.Locale] = translation
+		}
+	}
+
+	for i := range news {
+		news[i].Locale = s.config.DefaultLocale
+		for _, candidate := range chain {
+			if translation, ok := translated[news[i].ID][candidate]; ok {
+				news[i].Title, news[i].Content, news[i].Locale = translation.Title, translation.Content, candidate
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListTranslations returns the translations of news to the configured locales and the locales it misses.
+func (s *NewsService) ListTranslations(newsId int64) (models.NewsTranslations, error) {
+	if _, err := s.repo.GetNewsByID(newsId); err != nil {
+		return models.NewsTranslations{}, err
+	}
+
+	locales := s.translationLocales()
+	result := models.NewsTranslations{
+		NewsId:        newsId,
+		DefaultLocale: s.config.DefaultLocale,
+		Translations:  []models.NewsTranslation{},
+		Missing:       []string{},
+	}
+
+	if len(locales) > 0 {
+		var err error
+		if result.Translations, err = s.repo.GetTranslations([]int64{newsId}, locales); err != nil {
+			return models.NewsTranslations{}, err
+		}
+	}
+
+	for _, locale := range locales {
+		if !slices.ContainsFunc(result.Translations, func(t models.NewsTranslation) bool { return t.Locale == locale }) {
+			result.Missing = append(result.Missing, locale)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *NewsService) DeleteTranslation(newsId int64, locale string) error {
+	if err := s.checkLocale(locale); err != nil {
+		return err
+	}
+
+	if locale == s.config.DefaultLocale {
+		return apperrors.NewBadRequest(fmt.Sprintf("%s is the default locale news is written in, it has no translation", locale))
+	}
+
+	if err := s.repo.DeleteTranslation(newsId, locale); err != nil {
+		return err
+	}
+
+	s.notify(newsId)
+	return nil
+}
+
+// TranslationCompleteness reports, for each configured locale, how much of the news matching filter is translated
+// to it. Like ListNews, it covers published news unless statuses are given. The default locale is always complete.
+func (s *NewsService) TranslationCompleteness(filter models.NewsFilter) ([]models.TranslationCompleteness, error) {
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []string{models.NewsStatusPublished}
+	}
+
+	total, err := s.repo.CountNews(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64)
+	if locales := s.translationLocales(); len(locales) > 0 {
+		if counts, err = s.repo.CountTranslations(filter, locales); err != nil {
+			return nil, err
+		}
+	}
+	counts[s.config.DefaultLocale] = total
+
+	report := make([]models.TranslationCompleteness, 0, len(s.config.Locales))
+	for _, locale := range s.locales() {
+		report = append(report, models.TranslationCompleteness{
+			Locale:     locale,
+			Translated: counts[locale],
+			Total:      total,
+			Percent:    percent(counts[locale], total),
+		})
+	}
+
+	return report, nil
+}
+
+// editTranslation saves the title and content of one translation of news. HTML content is sanitised like news itself.
+func (s *NewsService) editTranslation(newsId int64, editForm models.NewsEditForm) error {
+	if !editForm.IsTranslatable() {
+		return apperrors.NewValidation(fmt.Sprintf("lang: only Title and Content can be edited in the %s translation", editForm.Locale))
+	}
+
+	updateFields := make(map[string]interface{})
+	if err := s.sanitizeEditedContent(newsId, editForm, updateFields); err != nil {
+		return err
+	}
+
+	content := editForm.Content
+	if sanitized, ok := updateFields["content"]; ok {
+		value := sanitized.(string)
+		content = &value
+	}
+
+	if err := s.repo.SaveTranslation(newsId, editForm.Locale, editForm.Title, content, editForm.Version); err != nil {
+		return err
+	}
+
+	s.notify(newsId)
+	return nil
+}
+
+func (s *NewsService) checkLocale(locale string) error {
+	if !slices.Contains(s.locales(), locale) {
+		return apperrors.NewBadRequest(fmt.Sprintf("lang must be one of: %s", strings.Join(s.locales(), ", ")))
+	}
+	return nil
+}
+
+// locales lists the configured locales, starting with the default one.
+func (s *NewsService) locales() []string {
+	return append([]string{s.config.DefaultLocale}, s.translationLocales()...)
+}
+
+// translationLocales lists the configured locales news can be translated to, all but the default one.
+func (s *NewsService) translationLocales() []string {
+	locales := make([]string, 0, len(s.config.Locales))
+	for _, locale := range s.config.Locales {
+		if locale != s.config.DefaultLocale && !slices.Contains(locales, locale) {
+			locales = append(locales, locale)
+		}
+	}
+	return locales
+}
+
+// localeChain lists the translations to look for, in order, before falling back to news as written in the
+// default locale: the requested locale and then the fallback ones.
+func (s *NewsService) localeChain(locale string) []string {
+	chain := make([]string, 0, len(s.config.FallbackLocales)+1)
+	for _, candidate := range append([]string{locale}, s.config.FallbackLocales...) {
+		// News itself is in the default locale, so no later locale is ever reached.
+		if candidate == s.config.DefaultLocale {
+			break
+		}
+		if !slices.Contains(chain, candidate) {
+			chain = append(chain, candidate)
+		}
+	}
+	return chain
+}
+
+// percent returns part of total in percent, rounded to one decimal. Nothing to translate counts as complete.
+func percent(part, total int64) float64 {
+	if total == 0 {
+		return 100
+	}
+	return math.Round(float64(part)*1000/float64(total)) / 10
+}
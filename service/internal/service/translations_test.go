@@ -0,0 +1,205 @@
+package service
+
+import (
+	"service/internal/apperrors"
+	"service/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLocalizeNews(t *testing.T) {
+	newsList := func() []models.NewsWithCategories {
+		return []models.NewsWithCategories{
+			{News: models.News{ID: 1, Title: "Погода", Content: "Солнечно"}},
+			{News: models.News{ID: 2, Title: "Спорт", Content: "Матч"}},
+			{News: models.News{ID: 3, Title: "Кино", Content: "Премьера"}},
+		}
+	}
+
+	t.Run("SuccessWithFallback", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		mockRepo.On("GetTranslations", []int64{1, 2, 3}, []string{"de", "en"}).Return([]models.NewsTranslation{
+			{NewsId: 1, Locale: "de", Title: "Wetter", Content: "Sonnig"},
+			{NewsId: 1, Locale: "en", Title: "Weather", Content: "Sunny"},
+			{NewsId: 2, Locale: "en", Title: "Sport", Content: "Match"},
+		}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		news := newsList()
+		err := service.LocalizeNews("de", news)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Wetter", "Sport", "Кино"}, []string{news[0].Title, news[1].Title, news[2].Title})
+		assert.Equal(t, []string{"Sonnig", "Match", "Премьера"}, []string{news[0].Content, news[1].Content, news[2].Content})
+		assert.Equal(t, []string{"de", "en", "ru"}, []string{news[0].Locale, news[1].Locale, news[2].Locale})
+	})
+
+	t.Run("SuccessDefaultLocale", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		news := newsList()
+		err := service.LocalizeNews("ru", news)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Погода", news[0].Title)
+		assert.Equal(t, "ru", news[0].Locale)
+		mockRepo.AssertNotCalled(t, "GetTranslations", mock.Anything, mock.Anything)
+	})
+
+	t.Run("FailedUnknownLocale", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		err := service.LocalizeNews("fr", newsList())
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidBody)
+		assert.EqualError(t, err, "lang must be one of: ru, en, de")
+	})
+}
+
+func TestEditTranslation(t *testing.T) {
+	var newsId int64 = 10
+	title := "Weather"
+	content := "<p onclick=\"alert(1)\">Sunny</p>"
+	version := int64(3)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		mockRepo.On("SaveTranslation", newsId, "en", &title, (*string)(nil), &version).Return(nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		err := service.EditNews(newsId, models.NewsEditForm{Title: &title, Version: &version, Locale: "en"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("SuccessSanitizesHTML", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		sanitized := "<p>Sunny</p>"
+		mockRepo.On("GetNewsByID", newsId).Return(models.NewsWithCategories{
+			News: models.News{ID: newsId, ContentFormat: models.ContentFormatHTML},
+		}, nil)
+		mockRepo.On("SaveTranslation", newsId, "en", &title, &sanitized, (*int64)(nil)).Return(nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		err := service.EditNews(newsId, models.NewsEditForm{Title: &title, Content: &content, Locale: "en"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("SuccessDefaultLocaleEditsNews", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		mockRepo.On("UpdateNews", newsId, map[string]interface{}{"title": title}, (*[]int64)(nil), (*[]int64)(nil), (*int64)(nil), "").Return(nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		err := service.EditNews(newsId, models.NewsEditForm{Title: &title, Locale: "ru"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("FailedNotTranslatable", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		err := service.EditNews(newsId, models.NewsEditForm{Title: &title, Categories: &[]int64{1}, Locale: "en"})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.EqualError(t, err, "lang: only Title and Content can be edited in the en translation")
+	})
+
+	t.Run("FailedUnknownLocale", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		err := service.EditNews(newsId, models.NewsEditForm{Title: &title, Locale: "fr"})
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidBody)
+	})
+}
+
+func TestListTranslations(t *testing.T) {
+	var newsId int64 = 10
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		translations := []models.NewsTranslation{{NewsId: newsId, Locale: "en", Title: "Weather", Content: "Sunny"}}
+		mockRepo.On("GetNewsByID", newsId).Return(models.NewsWithCategories{News: models.News{ID: newsId}}, nil)
+		mockRepo.On("GetTranslations", []int64{newsId}, []string{"en", "de"}).Return(translations, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		result, err := service.ListTranslations(newsId)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.NewsTranslations{
+			NewsId:        newsId,
+			DefaultLocale: "ru",
+			Translations:  translations,
+			Missing:       []string{"de"},
+		}, result)
+	})
+
+	t.Run("FailedNotFound", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		mockRepo.On("GetNewsByID", newsId).Return(models.NewsWithCategories{}, apperrors.NewNotFound("News not found"))
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		_, err := service.ListTranslations(newsId)
+
+		assert.ErrorIs(t, err, apperrors.ErrNewsNotFound)
+	})
+}
+
+func TestDeleteTranslation(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		mockRepo.On("DeleteTranslation", int64(10), "en").Return(nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		assert.NoError(t, service.DeleteTranslation(10, "en"))
+	})
+
+	t.Run("FailedDefaultLocale", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		err := service.DeleteTranslation(10, "ru")
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidBody)
+	})
+}
+
+func TestTranslationCompleteness(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		filter := models.NewsFilter{Statuses: []string{models.NewsStatusPublished}}
+		mockRepo.On("CountNews", filter).Return(int64(3), nil)
+		mockRepo.On("CountTranslations", filter, []string{"en", "de"}).Return(map[string]int64{"en": 2}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		report, err := service.TranslationCompleteness(models.NewsFilter{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.TranslationCompleteness{
+			{Locale: "ru", Translated: 3, Total: 3, Percent: 100},
+			{Locale: "en", Translated: 2, Total: 3, Percent: 66.7},
+			{Locale: "de", Translated: 0, Total: 3, Percent: 0},
+		}, report)
+	})
+
+	t.Run("SuccessNoNews", func(t *testing.T) {
+		mockRepo, mockCategoryRepo := setupRepo(t)
+		mockRepo.On("CountNews", mock.Anything).Return(int64(0), nil)
+		mockRepo.On("CountTranslations", mock.Anything, mock.Anything).Return(map[string]int64{}, nil)
+		service := NewNewsService(mockRepo, mockCategoryRepo, testClock, testLogger, testConfig, nil)
+
+		report, err := service.TranslationCompleteness(models.NewsFilter{})
+
+		assert.NoError(t, err)
+		for _, locale := range report {
+			assert.Equal(t, float64(100), locale.Percent, locale.Locale)
+		}
+	})
+}
@@ -0,0 +1,402 @@
+package sitemap
+
+import (
+	"context"
+	"encoding/xml"
+	"service/internal/apperrors"
+	"service/internal/configs"
+	"service/internal/models"
+	"service/internal/repository"
+	"service/pkg/clock"
+	"service/pkg/logger"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	newsNamespace    = "http://www.google.com/schemas/sitemap-news/0.9"
+)
+
+// Sitemap renders the sitemap index, the sitemap files of published news and the Google News sitemap,
+// and keeps them in memory. News is split into files by ID ranges of models.SitemapChunkSize, so when news
+// changes only the files of its ranges are rendered again, along with the index and the news sitemap.
+type Sitemap struct {
+	repo     repository.INewsRepository
+	clock    clock.Clock
+	config   configs.Sitemap
+	interval time.Duration
+	log      *logger.Logger
+
+	mu sync.Mutex
+	// index, news and the body of a chunk are nil when they have to be rendered again.
+	index  []byte
+	chunks map[int64]*chunk
+	news   []byte
+
+	// NewsChanged records changes under its own lock, so it never waits for sitemaps being rendered.
+	pendingMu  sync.Mutex
+	pending    map[int64]bool
+	pendingAll bool
+	changed    chan struct{}
+
+	runMu   sync.Mutex
+	running bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+type chunk struct {
+	lastMod time.Time
+	body    []byte
+}
+
+func NewSitemap(repo repository.INewsRepository, clock clock.Clock, config configs.Sitemap, log *logger.Logger) *Sitemap {
+	return &Sitemap{
+		repo:     repo,
+		clock:    clock,
+		config:   config,
+		interval: time.Duration(config.RefreshInterval) * time.Second,
+		log:      log,
+		chunks:   make(map[int64]*chunk),
+		pending:  make(map[int64]bool),
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+// Start renders all sitemaps in the background, then renders again what NewsChanged marks as changed
+// and everything every refresh interval.
+func (s *Sitemap) Start() {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	if s.running {
+		return
+	}
+	s.running = true
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+
+	stop, done := s.stop, s.done
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.Rebuild()
+		for {
+			select {
+			case <-ticker.C:
+				s.Rebuild()
+			case <-s.changed:
+				s.Refresh()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func (s *Sitemap) Stop(ctx context.Context) error {
+	s.runMu.Lock()
+	if !s.running {
+		s.runMu.Unlock()
+		return nil
+	}
+	s.running = false
+	close(s.stop)
+	done := s.done
+	s.runMu.Unlock()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// NewsChanged marks the sitemaps listing the news as changed, all of them when no IDs are given.
+// It is called once the change is committed and only wakes the background refresh.
+func (s *Sitemap) NewsChanged(ids ...int64) {
+	s.pendingMu.Lock()
+	if len(ids) == 0 {
+		s.pendingAll = true
+	}
+	for _, id := range ids {
+		s.pending[chunkNumber(id)] = true
+	}
+	s.pendingMu.Unlock()
+
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
+// Rebuild renders all sitemaps again.
+func (s *Sitemap) Rebuild() {
+	s.mu.Lock()
+	s.chunks = make(map[int64]*chunk)
+	s.index = nil
+	s.news = nil
+	s.mu.Unlock()
+
+	s.Refresh()
+}
+
+// Refresh renders the sitemaps marked as changed.
+func (s *Sitemap) Refresh() {
+	const op = "sitemap.Refresh"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.applyPending()
+	if err := s.renderIndex(); err != nil {
+		s.log.WithError(err).WithField("operation", op).Error("Failed to render sitemap index")
+		return
+	}
+
+	var rendered int
+	for number, c := range s.chunks {
+		if c.body != nil {
+			continue
+		}
+		if err := s.renderChunk(number, c); err != nil {
+			s.log.WithError(err).WithField("operation", op).Error("Failed to render sitemap")
+			return
+		}
+		rendered++
+	}
+
+	if err := s.renderNews(); err != nil {
+		s.log.WithError(err).WithField("operation", op).Error("Failed to render news sitemap")
+		return
+	}
+
+	if rendered > 0 {
+		s.log.WithFields(logrus.Fields{
+			"operation": op,
+			"rendered":  rendered,
+			"sitemaps":  len(s.chunks),
+		}).Debug("Sitemaps rendered")
+	}
+}
+
+// Index returns the sitemap index listing the sitemap files.
+func (s *Sitemap) Index() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.applyPending()
+	if err := s.renderIndex(); err != nil {
+		return nil, err
+	}
+
+	return s.index, nil
+}
+
+// Chunk returns sitemap file number, numbered from 1 as in the index.
+func (s *Sitemap) Chunk(number int64) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.applyPending()
+	if err := s.renderIndex(); err != nil {
+		return nil, err
+	}
+
+	c, ok := s.chunks[number]
+	if !ok {
+		return nil, apperrors.NewNotFound("Sitemap not found")
+	}
+
+	if c.body == nil {
+		if err := s.renderChunk(number, c); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.body, nil
+}
+
+// News returns the Google News sitemap of news published in the last two days.
+func (s *Sitemap) News() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.applyPending()
+	if err := s.renderNews(); err != nil {
+		return nil, err
+	}
+
+	return s.news, nil
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name       `xml:"sitemapindex"`
+	XMLNS    string         `xml:"xmlns,attr"`
+	Sitemaps []sitemapEntry `xml:"sitemap"`
+}
+
+type sitemapEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod"`
+}
+
+type urlSet struct {
+	XMLName   xml.Name `xml:"urlset"`
+	XMLNS     string   `xml:"xmlns,attr"`
+	XMLNSNews string   `xml:"xmlns:news,attr,omitempty"`
+	URLs      []url    `xml:"url"`
+}
+
+type url struct {
+	Loc     string    `xml:"loc"`
+	LastMod string    `xml:"lastmod,omitempty"`
+	News    *newsInfo `xml:"news:news,omitempty"`
+}
+
+type newsInfo struct {
+	Publication     publication `xml:"news:publication"`
+	PublicationDate string      `xml:"news:publication_date"`
+	Title           string      `xml:"news:title"`
+}
+
+type publication struct {
+	Name     string `xml:"news:name"`
+	Language string `xml:"news:language"`
+}
+
+// applyPending marks what NewsChanged recorded for rendering again. The index and the news sitemap
+// are rendered again after any change, as news may have been published, removed or retitled.
+func (s *Sitemap) applyPending() {
+	s.pendingMu.Lock()
+	pending, all := s.pending, s.pendingAll
+	s.pending, s.pendingAll = make(map[int64]bool), false
+	s.pendingMu.Unlock()
+
+	if len(pending) == 0 && !all {
+		return
+	}
+
+	s.index = nil
+	s.news = nil
+	for number, c := range s.chunks {
+		if all || pending[number] {
+			c.body = nil
+		}
+	}
+}
+
+// renderIndex loads the sitemap files unless the index is up to date. Files whose news has not changed keep their body.
+func (s *Sitemap) renderIndex() error {
+	if s.index != nil {
+		return nil
+	}
+
+	loaded, err := s.repo.GetSitemapChunks(models.SitemapChunkSize)
+	if err != nil {
+		return err
+	}
+
+	index := sitemapIndex{XMLNS: sitemapNamespace, Sitemaps: make([]sitemapEntry, 0, len(loaded))}
+	chunks := make(map[int64]*chunk, len(loaded))
+	for _, l := range loaded {
+		c := &chunk{lastMod: l.LastMod}
+		if old, ok := s.chunks[l.Number]; ok && old.lastMod.Equal(l.LastMod) {
+			c.body = old.body
+		}
+		chunks[l.Number] = c
+
+		index.Sitemaps = append(index.Sitemaps, sitemapEntry{
+			Loc:     s.config.BaseURL + "/sitemap-" + strconv.FormatInt(l.Number, 10) + ".xml",
+			LastMod: formatTime(l.LastMod),
+		})
+	}
+
+	body, err := render(index)
+	if err != nil {
+		return err
+	}
+
+	s.index = body
+	s.chunks = chunks
+	return nil
+}
+
+func (s *Sitemap) renderChunk(number int64, c *chunk) error {
+	entries, err := s.repo.GetSitemapEntries(number, models.SitemapChunkSize)
+	if err != nil {
+		return err
+	}
+
+	set := urlSet{XMLNS: sitemapNamespace, URLs: make([]url, 0, len(entries))}
+	for _, entry := range entries {
+		set.URLs = append(set.URLs, url{Loc: s.newsURL(entry.ID), LastMod: formatTime(entry.UpdatedAt)})
+	}
+
+	if c.body, err = render(set); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Sitemap) renderNews() error {
+	if s.news != nil {
+		return nil
+	}
+
+	entries, err := s.repo.GetRecentlyPublished(s.clock.Now().Add(-models.NewsSitemapMaxAge), models.NewsSitemapSize)
+	if err != nil {
+		return err
+	}
+
+	set := urlSet{XMLNS: sitemapNamespace, XMLNSNews: newsNamespace, URLs: make([]url, 0, len(entries))}
+	for _, entry := range entries {
+		info := &newsInfo{
+			Publication: publication{Name: s.config.PublicationName, Language: s.config.Language},
+			Title:       entry.Title,
+		}
+		if entry.PublishedAt != nil {
+			info.PublicationDate = formatTime(*entry.PublishedAt)
+		}
+
+		set.URLs = append(set.URLs, url{Loc: s.newsURL(entry.ID), News: info})
+	}
+
+	if s.news, err = render(set); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Sitemap) newsURL(id int64) string {
+	return strings.ReplaceAll(s.config.URLTemplate, "{id}", strconv.FormatInt(id, 10))
+}
+
+// chunkNumber is the number of the sitemap file listing news with the ID.
+func chunkNumber(id int64) int64 {
+	return (id-1)/models.SitemapChunkSize + 1
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func render(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
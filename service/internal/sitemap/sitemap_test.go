@@ -0,0 +1,279 @@
+package sitemap
+
+import (
+	"context"
+	"errors"
+	"service/internal/apperrors"
+	"service/internal/configs"
+	"service/internal/models"
+	"service/internal/repository/mocks"
+	customLog "service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testLogger = func() *customLog.Logger {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	return &customLog.Logger{Logger: log}
+}()
+
+var testConfig = configs.Sitemap{
+	URLTemplate:     "https://example.com/news/{id}",
+	BaseURL:         "https://example.com",
+	PublicationName: "Example News",
+	Language:        "en",
+	RefreshInterval: 300,
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func setupRepo(t *testing.T) *mocks.INewsRepository {
+	mockRepo := new(mocks.INewsRepository)
+
+	t.Cleanup(func() {
+		mockRepo.AssertExpectations(t)
+	})
+
+	return mockRepo
+}
+
+var (
+	lastMod     = time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	publishedAt = time.Date(2025, 12, 20, 9, 0, 0, 0, time.UTC)
+)
+
+func TestIndex(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return([]models.SitemapChunk{
+			{Number: 1, LastMod: lastMod},
+			{Number: 3, LastMod: lastMod.Add(time.Hour)},
+		}, nil).Once()
+
+		sitemap := NewSitemap(mockRepo, &fakeClock{}, testConfig, testLogger)
+
+		body, err := sitemap.Index()
+
+		assert.NoError(t, err)
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <sitemap>
+    <loc>https://example.com/sitemap-1.xml</loc>
+    <lastmod>2025-12-20T10:00:00Z</lastmod>
+  </sitemap>
+  <sitemap>
+    <loc>https://example.com/sitemap-3.xml</loc>
+    <lastmod>2025-12-20T11:00:00Z</lastmod>
+  </sitemap>
+</sitemapindex>`, string(body))
+
+		// The index is kept until news changes.
+		_, err = sitemap.Index()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return(nil, errors.New("db error")).Once()
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return([]models.SitemapChunk{}, nil).Once()
+
+		sitemap := NewSitemap(mockRepo, &fakeClock{}, testConfig, testLogger)
+
+		_, err := sitemap.Index()
+		assert.Error(t, err)
+
+		body, err := sitemap.Index()
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></sitemapindex>`)
+	})
+}
+
+func TestChunk(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).
+			Return([]models.SitemapChunk{{Number: 1, LastMod: lastMod}}, nil).Once()
+		mockRepo.On("GetSitemapEntries", int64(1), int64(models.SitemapChunkSize)).Return([]models.SitemapEntry{
+			{ID: 1, Title: "First", UpdatedAt: lastMod.Add(-time.Hour)},
+			{ID: 2, Title: "Second", UpdatedAt: lastMod},
+		}, nil).Once()
+
+		sitemap := NewSitemap(mockRepo, &fakeClock{}, testConfig, testLogger)
+
+		body, err := sitemap.Chunk(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url>
+    <loc>https://example.com/news/1</loc>
+    <lastmod>2025-12-20T09:00:00Z</lastmod>
+  </url>
+  <url>
+    <loc>https://example.com/news/2</loc>
+    <lastmod>2025-12-20T10:00:00Z</lastmod>
+  </url>
+</urlset>`, string(body))
+
+		_, err = sitemap.Chunk(1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).
+			Return([]models.SitemapChunk{{Number: 1, LastMod: lastMod}}, nil).Once()
+
+		sitemap := NewSitemap(mockRepo, &fakeClock{}, testConfig, testLogger)
+
+		_, err := sitemap.Chunk(2)
+
+		var appErr *apperrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 404, appErr.StatusCode)
+	})
+}
+
+func TestNews(t *testing.T) {
+	now := time.Date(2025, 12, 21, 6, 0, 0, 0, time.UTC)
+
+	mockRepo := setupRepo(t)
+	mockRepo.On("GetRecentlyPublished", now.Add(-models.NewsSitemapMaxAge), int64(models.NewsSitemapSize)).
+		Return([]models.SitemapEntry{{ID: 7, Title: "Tom & Jerry", UpdatedAt: lastMod, PublishedAt: &publishedAt}}, nil).Once()
+
+	sitemap := NewSitemap(mockRepo, &fakeClock{now: now}, testConfig, testLogger)
+
+	body, err := sitemap.News()
+
+	assert.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:news="http://www.google.com/schemas/sitemap-news/0.9">
+  <url>
+    <loc>https://example.com/news/7</loc>
+    <news:news>
+      <news:publication>
+        <news:name>Example News</news:name>
+        <news:language>en</news:language>
+      </news:publication>
+      <news:publication_date>2025-12-20T09:00:00Z</news:publication_date>
+      <news:title>Tom &amp; Jerry</news:title>
+    </news:news>
+  </url>
+</urlset>`, string(body))
+}
+
+func TestNewsChanged(t *testing.T) {
+	chunks := []models.SitemapChunk{
+		{Number: 1, LastMod: lastMod},
+		{Number: 2, LastMod: lastMod},
+	}
+
+	t.Run("RendersOnlyChangedChunks", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return(chunks, nil).Twice()
+		mockRepo.On("GetSitemapEntries", int64(1), int64(models.SitemapChunkSize)).
+			Return([]models.SitemapEntry{{ID: 1, UpdatedAt: lastMod}}, nil).Once()
+		mockRepo.On("GetSitemapEntries", int64(2), int64(models.SitemapChunkSize)).
+			Return([]models.SitemapEntry{{ID: models.SitemapChunkSize + 1, UpdatedAt: lastMod}}, nil).Twice()
+		mockRepo.On("GetRecentlyPublished", time.Time{}.Add(-models.NewsSitemapMaxAge), int64(models.NewsSitemapSize)).
+			Return([]models.SitemapEntry{}, nil).Twice()
+
+		sitemap := NewSitemap(mockRepo, &fakeClock{}, testConfig, testLogger)
+		sitemap.Refresh()
+
+		sitemap.NewsChanged(models.SitemapChunkSize + 1)
+		sitemap.Refresh()
+
+		// Nothing changed since, so nothing is loaded again.
+		sitemap.Refresh()
+		_, err := sitemap.Chunk(1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RendersAllWithoutIDs", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return(chunks, nil).Twice()
+		mockRepo.On("GetSitemapEntries", int64(1), int64(models.SitemapChunkSize)).
+			Return([]models.SitemapEntry{}, nil).Twice()
+		mockRepo.On("GetSitemapEntries", int64(2), int64(models.SitemapChunkSize)).
+			Return([]models.SitemapEntry{}, nil).Twice()
+		mockRepo.On("GetRecentlyPublished", time.Time{}.Add(-models.NewsSitemapMaxAge), int64(models.NewsSitemapSize)).
+			Return([]models.SitemapEntry{}, nil).Twice()
+
+		sitemap := NewSitemap(mockRepo, &fakeClock{}, testConfig, testLogger)
+		sitemap.Refresh()
+
+		sitemap.NewsChanged()
+		sitemap.Refresh()
+	})
+
+	t.Run("RendersChunkOfNewNews", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return(chunks[:1], nil).Once()
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return(chunks, nil).Once()
+		mockRepo.On("GetSitemapEntries", int64(1), int64(models.SitemapChunkSize)).
+			Return([]models.SitemapEntry{}, nil).Once()
+		mockRepo.On("GetSitemapEntries", int64(2), int64(models.SitemapChunkSize)).
+			Return([]models.SitemapEntry{}, nil).Once()
+		mockRepo.On("GetRecentlyPublished", time.Time{}.Add(-models.NewsSitemapMaxAge), int64(models.NewsSitemapSize)).
+			Return([]models.SitemapEntry{}, nil).Twice()
+
+		sitemap := NewSitemap(mockRepo, &fakeClock{}, testConfig, testLogger)
+		sitemap.Refresh()
+
+		sitemap.NewsChanged(models.SitemapChunkSize + 1)
+		sitemap.Refresh()
+	})
+}
+
+func TestStartStop(t *testing.T) {
+	t.Run("RendersOnStartAndChange", func(t *testing.T) {
+		mockRepo := setupRepo(t)
+		rendered := make(chan struct{}, 1)
+
+		mockRepo.On("GetSitemapChunks", int64(models.SitemapChunkSize)).Return([]models.SitemapChunk{}, nil).Twice()
+		mockRepo.On("GetRecentlyPublished", mock.Anything, int64(models.NewsSitemapSize)).
+			Return([]models.SitemapEntry{}, nil).
+			Run(func(mock.Arguments) {
+				rendered <- struct{}{}
+			}).Twice()
+
+		sitemap := NewSitemap(mockRepo, &fakeClock{}, testConfig, testLogger)
+		sitemap.Start()
+
+		waitRendered := func() {
+			select {
+			case <-rendered:
+			case <-time.After(time.Second):
+				t.Fatal("sitemap was not rendered")
+			}
+		}
+
+		waitRendered()
+		sitemap.NewsChanged(1)
+		waitRendered()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		assert.NoError(t, sitemap.Stop(ctx))
+	})
+
+	t.Run("StopWithoutStart", func(t *testing.T) {
+		sitemap := NewSitemap(setupRepo(t), &fakeClock{}, testConfig, testLogger)
+
+		assert.NoError(t, sitemap.Stop(context.Background()))
+	})
+}
@@ -0,0 +1,19 @@
+package validators
+
+import (
+	"fmt"
+	"service/internal/apperrors"
+)
+
+const maxBulkItems = 500
+
+func ValidateBulkSize(count int) error {
+	if count == 0 {
+		return apperrors.NewValidation("body: must contain at least one news")
+	}
+	if count > maxBulkItems {
+		return apperrors.NewValidation(fmt.Sprintf("body: must contain at most %d news", maxBulkItems))
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package validators
+
+import (
+	"service/internal/apperrors"
+	"service/internal/models"
+	"strconv"
+	"time"
+)
+
+// ValidateNewsCursor checks that a decoded cursor was issued for sort and holds a value of the right type for each key.
+func ValidateNewsCursor(cursor models.NewsCursor, sort models.NewsSort) error {
+	if cursor.Sort != sort.String() {
+		return apperrors.NewBadRequest("cursor was issued for a different sort order")
+	}
+
+	if cursor.ID <= 0 || len(cursor.Values) != len(sort) {
+		return apperrors.NewBadRequest("cursor is malformed")
+	}
+
+	for i, key := range sort {
+		if !validSortValue(key.Field, cursor.Values[i]) {
+			return apperrors.NewBadRequest("cursor is malformed")
+		}
+	}
+
+	return nil
+}
+
+func validSortValue(field string, value *string) bool {
+	if value == nil {
+		return field == models.NewsSortPublishedAt
+	}
+
+	switch field {
+	case models.NewsSortID:
+		_, err := strconv.ParseInt(*value, 10, 64)
+		return err == nil
+	case models.NewsSortTitle:
+		return true
+	case models.NewsSortCreatedAt, models.NewsSortUpdatedAt, models.NewsSortPublishedAt:
+		_, err := time.Parse(time.RFC3339Nano, *value)
+		return err == nil
+	default:
+		return false
+	}
+}
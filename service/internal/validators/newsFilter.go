@@ -0,0 +1,65 @@
+package validators
+
+import (
+	"fmt"
+	"service/internal/apperrors"
+	"service/internal/models"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxFilterCategories    = 50
+	maxTitleContainsLength = 255
+)
+
+func ValidateNewsFilter(filter models.NewsFilter) error {
+	if len(filter.CategoryIDs)+len(filter.ExcludeCategoryIDs) > maxFilterCategories {
+		return apperrors.NewBadRequest(fmt.Sprintf("category and exclude_category must contain at most %d IDs in total", maxFilterCategories))
+	}
+
+	if err := validatePositiveIDs(filter.CategoryIDs, "category"); err != nil {
+		return err
+	}
+
+	if err := validatePositiveIDs(filter.ExcludeCategoryIDs, "exclude_category"); err != nil {
+		return err
+	}
+
+	for _, id := range filter.CategoryIDs {
+		if slices.Contains(filter.ExcludeCategoryIDs, id) {
+			return apperrors.NewBadRequest(fmt.Sprintf("category %d cannot be both included and excluded", id))
+		}
+	}
+
+	if filter.CategoryMode != "" && !slices.Contains(models.CategoryModes, filter.CategoryMode) {
+		return apperrors.NewBadRequest(fmt.Sprintf("category_mode must be one of: %s", strings.Join(models.CategoryModes, ", ")))
+	}
+
+	for _, status := range filter.Statuses {
+		if !slices.Contains(models.NewsStatuses, status) {
+			return apperrors.NewBadRequest(fmt.Sprintf("status must be one of: %s", strings.Join(models.NewsStatuses, ", ")))
+		}
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && filter.CreatedFrom.After(*filter.CreatedTo) {
+		return apperrors.NewBadRequest("created_from must not be after created_to")
+	}
+
+	if utf8.RuneCountInString(filter.TitleContains) > maxTitleContainsLength {
+		return apperrors.NewBadRequest(fmt.Sprintf("title_contains must be at most %d characters", maxTitleContainsLength))
+	}
+
+	return nil
+}
+
+func validatePositiveIDs(ids []int64, name string) error {
+	for _, id := range ids {
+		if id <= 0 {
+			return apperrors.NewBadRequest(fmt.Sprintf("%s must be a positive number or a comma-separated list of them", name))
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,9 @@ package validators
 import (
 	"fmt"
 	"service/internal/apperrors"
+	"service/internal/models"
+	"slices"
+	"strings"
 )
 
 const (
@@ -25,3 +28,11 @@ func ValidatePaginationParams(limit, offset int64) error {
 
 	return nil
 }
+
+func ValidateCountMode(count string) error {
+	if !slices.Contains(models.CountModes, count) {
+		return apperrors.NewBadRequest(fmt.Sprintf("count must be one of: %s", strings.Join(models.CountModes, ", ")))
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package validators
+
+import (
+	"fmt"
+	"service/internal/apperrors"
+)
+
+const maxRelatedLimit int64 = 50
+
+func ValidateRelatedLimit(limit int64) error {
+	if limit < minLimit || limit > maxRelatedLimit {
+		return apperrors.NewBadRequest(fmt.Sprintf("limit must be between %d and %d", minLimit, maxRelatedLimit))
+	}
+
+	return nil
+}
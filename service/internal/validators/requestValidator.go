@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"time"
 )
 
 type ValidationError struct {
@@ -46,13 +47,28 @@ func ValidateCreateNewsRequest(data []byte) error {
 		}
 	}
 
+	if format, exists := raw["ContentFormat"]; exists && format != nil {
+		if _, ok := format.(string); !ok {
+			return &ValidationError{
+				Field:   "ContentFormat",
+				Message: "must be string",
+			}
+		}
+	}
+
 	if categories, exists := raw["Categories"]; exists && categories != nil {
-		if err := validateCategoriesArray(categories); err != nil {
+		if err := validateIDsArray("Categories", categories); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	if media, exists := raw["Media"]; exists && media != nil {
+		if err := validateIDsArray("Media", media); err != nil {
+			return err
+		}
+	}
+
+	return validateScheduleFields(raw)
 }
 
 func ValidateEditNewsRequest(data []byte) error {
@@ -64,7 +80,7 @@ func ValidateEditNewsRequest(data []byte) error {
 	if len(raw) == 0 {
 		return &ValidationError{
 			Field:   "body",
-			Message: "must contain at least one field to update (Title, Content, or Categories)",
+			Message: "must contain at least one field to update (Title, Content, ContentFormat, Categories, Media, PublishAt, or ExpiresAt)",
 		}
 	}
 
@@ -90,45 +106,101 @@ func ValidateEditNewsRequest(data []byte) error {
 		}
 	}
 
+	if format, exists := raw["ContentFormat"]; exists {
+		if format != nil {
+			if _, ok := format.(string); !ok {
+				return &ValidationError{
+					Field:   "ContentFormat",
+					Message: fmt.Sprintf("must be string, got %T", format),
+				}
+			}
+		}
+	}
+
 	if categories, exists := raw["Categories"]; exists {
 		if categories != nil {
-			if err := validateCategoriesArray(categories); err != nil {
+			if err := validateIDsArray("Categories", categories); err != nil {
 				return err
 			}
 		}
 	}
 
+	if media, exists := raw["Media"]; exists {
+		if media != nil {
+			if err := validateIDsArray("Media", media); err != nil {
+				return err
+			}
+		}
+	}
+
+	if version, exists := raw["Version"]; exists && version != nil {
+		number, ok := version.(float64)
+		if !ok || number != math.Trunc(number) {
+			return &ValidationError{
+				Field:   "Version",
+				Message: fmt.Sprintf("must be integer, got %T", version),
+			}
+		}
+	}
+
+	return validateScheduleFields(raw)
+}
+
+func validateScheduleFields(raw map[string]interface{}) error {
+	for _, field := range []string{"PublishAt", "ExpiresAt"} {
+		value, exists := raw[field]
+		if !exists || value == nil {
+			continue
+		}
+
+		str, ok := value.(string)
+		if !ok {
+			return &ValidationError{
+				Field:   field,
+				Message: fmt.Sprintf("must be string, got %T", value),
+			}
+		}
+
+		if _, err := time.Parse(time.RFC3339, str); err != nil {
+			return &ValidationError{
+				Field:   field,
+				Message: "must be RFC 3339 date-time, example: 2025-12-20T06:00:00+03:00",
+			}
+		}
+	}
+
 	return nil
 }
 
-func validateCategoriesArray(categories interface{}) error {
-	catSlice, ok := categories.([]interface{})
+// validateIDsArray checks that a field of IDs, such as Categories or Media, is an array of positive integers.
+func validateIDsArray(field string, ids interface{}) error {
+	idSlice, ok := ids.([]interface{})
 	if !ok {
 		return &ValidationError{
-			Field:   "Categories",
+			Field:   field,
 			Message: "must be array numbers",
 		}
 	}
 
-	for i, cat := range catSlice {
-		num, ok := cat.(float64)
+	for i, id := range idSlice {
+		num, ok := id.(float64)
 		if !ok {
 			return &ValidationError{
-				Field:   "Categories",
-				Message: fmt.Sprintf("element at index %d must be number, got %T", i, cat),
+				Field:   field,
+				Message: fmt.Sprintf("element at index %d must be number, got %T", i, id),
 			}
 		}
 
 		if num != math.Floor(num) {
 			return &ValidationError{
-				Field:   "Categories",
+				Field:   field,
 				Message: fmt.Sprintf("element at index %d must be integer, got %v", i, num),
 			}
 		}
 
 		if num <= 0 {
 			return &ValidationError{
-				Field:   "Categories",
+				Field:   field,
 				Message: fmt.Sprintf("element at index %d must be positive, got %v", i, num),
 			}
 		}
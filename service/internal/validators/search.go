@@ -0,0 +1,20 @@
+package validators
+
+import (
+	"fmt"
+	"service/internal/apperrors"
+	"unicode/utf8"
+)
+
+const maxSearchQueryLength = 200
+
+func ValidateSearchQuery(query string) error {
+	if query == "" {
+		return apperrors.NewBadRequest("q is required")
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return apperrors.NewBadRequest(fmt.Sprintf("q must be at most %d characters", maxSearchQueryLength))
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS categories (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT ''
+    );
+
+INSERT INTO categories (id, name, slug)
+SELECT DISTINCT category_id, 'Category ' || category_id, 'category-' || category_id
+FROM news_categories
+ON CONFLICT (id) DO NOTHING;
+
+SELECT setval(pg_get_serial_sequence('categories', 'id'), COALESCE((SELECT MAX(id) FROM categories), 0) + 1, false);
+
+ALTER TABLE news_categories
+    ADD CONSTRAINT fk_category FOREIGN KEY (category_id) REFERENCES categories(id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE news_categories DROP CONSTRAINT IF EXISTS fk_category;
+DROP TABLE IF EXISTS categories;
+-- +goose StatementEnd
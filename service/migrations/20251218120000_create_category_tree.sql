@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS parent_id BIGINT NULL,
+    ADD CONSTRAINT fk_parent_category FOREIGN KEY (parent_id) REFERENCES categories(id);
+
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories (parent_id);
+
+CREATE TABLE IF NOT EXISTS category_closure (
+    ancestor_id BIGINT NOT NULL,
+    descendant_id BIGINT NOT NULL,
+    depth INT NOT NULL,
+    PRIMARY KEY (ancestor_id, descendant_id),
+    CONSTRAINT fk_closure_ancestor FOREIGN KEY (ancestor_id) REFERENCES categories(id) ON DELETE CASCADE,
+    CONSTRAINT fk_closure_descendant FOREIGN KEY (descendant_id) REFERENCES categories(id) ON DELETE CASCADE
+    );
+
+CREATE INDEX IF NOT EXISTS idx_category_closure_descendant ON category_closure (descendant_id);
+
+INSERT INTO category_closure (ancestor_id, descendant_id, depth)
+SELECT id, id, 0
+FROM categories
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS category_closure;
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS fk_parent_category;
+ALTER TABLE categories DROP COLUMN IF EXISTS parent_id;
+-- +goose StatementEnd
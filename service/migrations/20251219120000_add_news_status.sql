@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'published'
+        CONSTRAINT chk_news_status CHECK (status IN ('draft', 'review', 'published', 'archived'));
+
+ALTER TABLE news ALTER COLUMN status SET DEFAULT 'draft';
+
+CREATE INDEX IF NOT EXISTS idx_news_status ON news (status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_news_status;
+ALTER TABLE news DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
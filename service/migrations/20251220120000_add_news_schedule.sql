@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_news_publish_at ON news (publish_at) WHERE status = 'review' AND publish_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_news_expires_at ON news (expires_at) WHERE status = 'published' AND expires_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_news_expires_at;
+DROP INDEX IF EXISTS idx_news_publish_at;
+ALTER TABLE news
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS publish_at;
+-- +goose StatementEnd
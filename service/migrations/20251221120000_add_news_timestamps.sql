@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ NULL;
+
+UPDATE news
+SET published_at = COALESCE(publish_at, created_at)
+WHERE status IN ('published', 'archived');
+
+CREATE INDEX IF NOT EXISTS idx_news_created_at ON news (created_at);
+CREATE INDEX IF NOT EXISTS idx_news_updated_at ON news (updated_at);
+CREATE INDEX IF NOT EXISTS idx_news_published_at ON news (published_at);
+
+CREATE OR REPLACE FUNCTION news_set_timestamps() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.created_at = NOW();
+    ELSE
+        NEW.created_at = OLD.created_at;
+    END IF;
+
+    NEW.updated_at = NOW();
+
+    IF NEW.status = 'published' AND (TG_OP = 'INSERT' OR OLD.status IS DISTINCT FROM 'published') THEN
+        NEW.published_at = NOW();
+    ELSIF TG_OP = 'UPDATE' THEN
+        NEW.published_at = OLD.published_at;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_news_set_timestamps
+    BEFORE INSERT OR UPDATE
+    ON news
+    FOR EACH ROW
+EXECUTE FUNCTION news_set_timestamps();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_news_set_timestamps ON news;
+DROP FUNCTION IF EXISTS news_set_timestamps();
+DROP INDEX IF EXISTS idx_news_published_at;
+DROP INDEX IF EXISTS idx_news_updated_at;
+DROP INDEX IF EXISTS idx_news_created_at;
+ALTER TABLE news
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS created_at;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS news_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    news_id BIGINT NOT NULL,
+    revision INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL,
+    categories BIGINT[] NOT NULL DEFAULT '{}',
+    editor VARCHAR(255) NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_news_revision UNIQUE (news_id, revision),
+    CONSTRAINT fk_revision_news FOREIGN KEY (news_id) REFERENCES news(id) ON DELETE CASCADE
+    );
+
+INSERT INTO news_revisions (news_id, revision, title, content, categories, created_at)
+SELECT n.id,
+       1,
+       n.title,
+       n.content,
+       COALESCE((SELECT ARRAY_AGG(nc.category_id ORDER BY nc.category_id)
+                 FROM news_categories nc
+                 WHERE nc.news_id = n.id), '{}'),
+       n.updated_at
+FROM news n
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS news_revisions;
+-- +goose StatementEnd
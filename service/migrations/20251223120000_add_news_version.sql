@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION news_bump_version() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.version = 1;
+    ELSE
+        NEW.version = OLD.version + 1;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_news_bump_version
+    BEFORE INSERT OR UPDATE
+    ON news
+    FOR EACH ROW
+EXECUTE FUNCTION news_bump_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_news_bump_version ON news;
+DROP FUNCTION IF EXISTS news_bump_version();
+ALTER TABLE news
+    DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', title), 'A') ||
+        setweight(to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('russian', content), 'B') ||
+        setweight(to_tsvector('english', content), 'B')
+        ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_news_search_vector ON news USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_news_search_vector;
+ALTER TABLE news
+    DROP COLUMN IF EXISTS search_vector;
+-- +goose StatementEnd
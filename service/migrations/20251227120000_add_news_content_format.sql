@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS content_format VARCHAR(16) NOT NULL DEFAULT 'plain'
+        CONSTRAINT chk_news_content_format CHECK (content_format IN ('plain', 'markdown', 'html'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE news DROP COLUMN IF EXISTS content_format;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS media (
+    id BIGSERIAL PRIMARY KEY,
+    file_name VARCHAR(255) NOT NULL DEFAULT '',
+    content_type VARCHAR(32) NOT NULL,
+    size BIGINT NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    thumbnails JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    );
+
+CREATE TABLE IF NOT EXISTS news_media (
+    news_id BIGINT NOT NULL,
+    media_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (news_id, media_id),
+    CONSTRAINT fk_news_media_news FOREIGN KEY (news_id) REFERENCES news(id) ON DELETE CASCADE,
+    CONSTRAINT fk_news_media_media FOREIGN KEY (media_id) REFERENCES media(id)
+    );
+
+CREATE INDEX IF NOT EXISTS idx_news_media_media_id ON news_media (media_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS news_media;
+DROP TABLE IF EXISTS media;
+-- +goose StatementEnd
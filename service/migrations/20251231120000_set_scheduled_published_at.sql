@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION news_set_timestamps() RETURNS TRIGGER AS
+$$
+DECLARE
+    scheduled_at TIMESTAMPTZ := NULLIF(current_setting('news.scheduled_at', true), '')::TIMESTAMPTZ;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.created_at = NOW();
+    ELSE
+        NEW.created_at = OLD.created_at;
+    END IF;
+
+    NEW.updated_at = NOW();
+
+    IF NEW.status = 'published' AND TG_OP = 'UPDATE' AND OLD.status = 'review' AND NEW.publish_at IS NOT NULL
+        AND scheduled_at IS NOT NULL THEN
+        NEW.published_at = LEAST(GREATEST(NEW.publish_at, OLD.updated_at), scheduled_at);
+    ELSIF NEW.status = 'published' AND (TG_OP = 'INSERT' OR OLD.status IS DISTINCT FROM 'published') THEN
+        NEW.published_at = NOW();
+    ELSIF TG_OP = 'UPDATE' THEN
+        NEW.published_at = OLD.published_at;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION news_set_timestamps() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.created_at = NOW();
+    ELSE
+        NEW.created_at = OLD.created_at;
+    END IF;
+
+    NEW.updated_at = NOW();
+
+    IF NEW.status = 'published' AND (TG_OP = 'INSERT' OR OLD.status IS DISTINCT FROM 'published') THEN
+        NEW.published_at = NOW();
+    ELSIF TG_OP = 'UPDATE' THEN
+        NEW.published_at = OLD.published_at;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+package clock
+
+import "time"
+
+// Clock abstracts the current time so that time-dependent code can be tested.
+type Clock interface {
+	Now() time.Time
+}
+
+type Real struct{}
+
+func (Real) Now() time.Time {
+	return time.Now()
+}
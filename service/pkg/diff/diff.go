@@ -0,0 +1,135 @@
+package diff
+
+import "strings"
+
+const (
+	OpEqual  = "equal"
+	OpInsert = "insert"
+	OpDelete = "delete"
+)
+
+type Line struct {
+	Op   string `json:"Op" example:"insert"`
+	Text string `json:"Text"`
+}
+
+// maxCost bounds the edit distance searched for an optimal split of the inputs, keeping the time linear
+// in their size. Beyond it, the remaining lines are reported as deleted and inserted, which is a valid
+// but longer diff.
+const maxCost = 1000
+
+// Lines returns a line-level diff that turns a into b, found with the linear-space variant of
+// Myers' O(ND) algorithm.
+func Lines(a, b string) []Line {
+	a2, b2 := splitLines(a), splitLines(b)
+	result := make([]Line, 0, len(a2)+len(b2))
+	return compute(result, a2, b2)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
+
+// compute appends the diff of a and b to result: the common prefix and suffix are equal, and the rest
+// is split at the middle snake of an optimal path and diffed recursively.
+func compute(result []Line, a, b []string) []Line {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	result = appendLines(result, OpEqual, a[:prefix])
+
+	a2, b2 := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	switch {
+	case len(a2) == 0 || len(b2) == 0:
+		result = appendLines(result, OpDelete, a2)
+		result = appendLines(result, OpInsert, b2)
+	default:
+		x, y, u, v, ok := middleSnake(a2, b2)
+		if !ok {
+			result = appendLines(result, OpDelete, a2)
+			result = appendLines(result, OpInsert, b2)
+			break
+		}
+		result = compute(result, a2[:x], b2[:y])
+		result = appendLines(result, OpEqual, a2[x:u])
+		result = compute(result, a2[u:], b2[v:])
+	}
+
+	return appendLines(result, OpEqual, a[len(a)-suffix:])
+}
+
+func appendLines(result []Line, op string, lines []string) []Line {
+	for _, text := range lines {
+		result = append(result, Line{Op: op, Text: text})
+	}
+	return result
+}
+
+// middleSnake finds the snake (x, y)-(u, v) in the middle of a shortest edit path from a to b, searching
+// forward from the start and backward from the end at once. Both inputs must be non-empty and differ in
+// their first and last lines. ok is false when the edit distance exceeds twice maxCost.
+func middleSnake(a, b []string) (x, y, u, v int, ok bool) {
+	n, m := len(a), len(b)
+	delta := n - m
+	odd := delta%2 != 0
+	limit := min((n+m+1)/2, maxCost)
+
+	// forward[offset+k] is the furthest x reached on diagonal k = x - y from the start, backward[offset+k]
+	// the furthest number of lines of a consumed from the end on diagonal k of the reversed inputs.
+	offset := limit + 1
+	forward := make([]int, 2*limit+3)
+	backward := make([]int, 2*limit+3)
+
+	for d := 0; d <= limit; d++ {
+		for k := -d; k <= d; k += 2 {
+			if k == -d || (k != d && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1]
+			} else {
+				x = forward[offset+k-1] + 1
+			}
+			y = x - k
+			u, v = x, y
+			for u < n && v < m && a[u] == b[v] {
+				u++
+				v++
+			}
+			forward[offset+k] = u
+
+			if reverse := delta - k; odd && reverse >= -(d-1) && reverse <= d-1 && u+backward[offset+reverse] >= n {
+				return x, y, u, v, true
+			}
+		}
+
+		for k := -d; k <= d; k += 2 {
+			var rx int
+			if k == -d || (k != d && backward[offset+k-1] < backward[offset+k+1]) {
+				rx = backward[offset+k+1]
+			} else {
+				rx = backward[offset+k-1] + 1
+			}
+			ry := rx - k
+			endX, endY := rx, ry
+			for rx < n && ry < m && a[n-1-rx] == b[m-1-ry] {
+				rx++
+				ry++
+			}
+			backward[offset+k] = rx
+
+			if straight := delta - k; !odd && straight >= -d && straight <= d && forward[offset+straight]+rx >= n {
+				return n - rx, m - ry, n - endX, m - endY, true
+			}
+		}
+	}
+
+	return 0, 0, 0, 0, false
+}
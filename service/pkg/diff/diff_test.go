@@ -0,0 +1,170 @@
+package diff
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	testData := []struct {
+		name     string
+		a        string
+		b        string
+		expected []Line
+	}{
+		{
+			name:     "equal",
+			a:        "one\ntwo",
+			b:        "one\ntwo",
+			expected: []Line{{Op: OpEqual, Text: "one"}, {Op: OpEqual, Text: "two"}},
+		},
+		{
+			name: "changed line",
+			a:    "one\ntwo\nthree",
+			b:    "one\n2\nthree",
+			expected: []Line{
+				{Op: OpEqual, Text: "one"},
+				{Op: OpDelete, Text: "two"},
+				{Op: OpInsert, Text: "2"},
+				{Op: OpEqual, Text: "three"},
+			},
+		},
+		{
+			name: "inserted and deleted lines",
+			a:    "a\nb\nc\nd",
+			b:    "a\nc\nd\ne",
+			expected: []Line{
+				{Op: OpEqual, Text: "a"},
+				{Op: OpDelete, Text: "b"},
+				{Op: OpEqual, Text: "c"},
+				{Op: OpEqual, Text: "d"},
+				{Op: OpInsert, Text: "e"},
+			},
+		},
+		{
+			name:     "from empty",
+			a:        "",
+			b:        "new",
+			expected: []Line{{Op: OpInsert, Text: "new"}},
+		},
+		{
+			name:     "windows line endings",
+			a:        "one\r\ntwo",
+			b:        "one\ntwo",
+			expected: []Line{{Op: OpEqual, Text: "one"}, {Op: OpEqual, Text: "two"}},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run("Success_"+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Lines(tt.a, tt.b))
+		})
+	}
+}
+
+func TestLinesShortest(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	randomText := func() string {
+		lines := make([]string, random.Intn(12))
+		for i := range lines {
+			lines[i] = string(rune('a' + random.Intn(4)))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := randomText(), randomText()
+		result := Lines(a, b)
+
+		assertApplies(t, a, b, result)
+		assert.Equal(t, lcsLength(splitLines(a), splitLines(b)), count(result, OpEqual), "a=%q b=%q", a, b)
+	}
+}
+
+func TestLinesLarge(t *testing.T) {
+	t.Run("Success_few changes", func(t *testing.T) {
+		a, b := make([]string, 200_000), make([]string, 200_000)
+		for i := range a {
+			a[i] = fmt.Sprintf("line %d", i)
+			b[i] = a[i]
+			if i%1000 == 0 {
+				b[i] = fmt.Sprintf("changed %d", i)
+			}
+		}
+
+		start := time.Now()
+		result := Lines(strings.Join(a, "\n"), strings.Join(b, "\n"))
+
+		assert.Less(t, time.Since(start), 5*time.Second)
+		assertApplies(t, strings.Join(a, "\n"), strings.Join(b, "\n"), result)
+		assert.Equal(t, 200, count(result, OpDelete))
+		assert.Equal(t, 200, count(result, OpInsert))
+	})
+
+	t.Run("Success_nothing in common", func(t *testing.T) {
+		a, b := make([]string, 100_000), make([]string, 100_000)
+		for i := range a {
+			a[i] = fmt.Sprintf("old %d", i)
+			b[i] = fmt.Sprintf("new %d", i)
+		}
+
+		start := time.Now()
+		result := Lines(strings.Join(a, "\n"), strings.Join(b, "\n"))
+
+		assert.Less(t, time.Since(start), 5*time.Second)
+		assertApplies(t, strings.Join(a, "\n"), strings.Join(b, "\n"), result)
+		assert.Equal(t, 100_000, count(result, OpDelete))
+		assert.Equal(t, 100_000, count(result, OpInsert))
+	})
+}
+
+// assertApplies checks that the diff keeps the lines of a and b in order: equal and deleted lines make up a,
+// equal and inserted lines make up b.
+func assertApplies(t *testing.T, a, b string, result []Line) {
+	t.Helper()
+
+	var from, to []string
+	for _, line := range result {
+		if line.Op != OpInsert {
+			from = append(from, line.Text)
+		}
+		if line.Op != OpDelete {
+			to = append(to, line.Text)
+		}
+	}
+
+	assert.Equal(t, splitLines(a), from)
+	assert.Equal(t, splitLines(b), to)
+}
+
+func count(result []Line, op string) int {
+	n := 0
+	for _, line := range result {
+		if line.Op == op {
+			n++
+		}
+	}
+	return n
+}
+
+func lcsLength(a, b []string) int {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	return lcs[0][0]
+}
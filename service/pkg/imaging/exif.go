@@ -0,0 +1,84 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+const (
+	markerSOS          = 0xDA
+	markerAPP1         = 0xE1
+	tagOrientation     = 0x0112
+	ifdEntrySize       = 12
+	exifHeaderLength   = 6
+	tiffHeaderLength   = 8
+	defaultOrientation = 1
+)
+
+// jpegOrientation reads the orientation tag of the EXIF data of a JPEG image, 1 (as stored) when there is none.
+func jpegOrientation(data []byte) int {
+	// Segments follow the start of image marker, each a 0xFF marker byte pair and a length that counts itself.
+	for offset := 2; offset+4 <= len(data); {
+		if data[offset] != 0xFF {
+			return defaultOrientation
+		}
+
+		marker := data[offset+1]
+		if marker == markerSOS {
+			// Compressed image data follows, metadata segments all come before it.
+			return defaultOrientation
+		}
+
+		length := int(binary.BigEndian.Uint16(data[offset+2:]))
+		end := offset + 2 + length
+		if length < 2 || end > len(data) {
+			return defaultOrientation
+		}
+
+		segment := data[offset+4 : end]
+		if marker == markerAPP1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[exifHeaderLength:])
+		}
+
+		offset = end
+	}
+
+	return defaultOrientation
+}
+
+// tiffOrientation looks the orientation tag up in the first IFD of EXIF data, which is laid out as a TIFF file.
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < tiffHeaderLength {
+		return defaultOrientation
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return defaultOrientation
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < tiffHeaderLength || ifd+2 > len(tiff) {
+		return defaultOrientation
+	}
+
+	entries := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*ifdEntrySize
+		if entry+ifdEntrySize > len(tiff) {
+			return defaultOrientation
+		}
+
+		if order.Uint16(tiff[entry:]) == tagOrientation {
+			// The value is a SHORT stored in the first bytes of the value field.
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+
+	return defaultOrientation
+}
@@ -0,0 +1,78 @@
+package imaging
+
+import "encoding/binary"
+
+const (
+	gifHeaderLength         = 6
+	gifScreenDescriptorSize = 7
+	gifImageDescriptorSize  = 9
+	gifBlockExtension       = 0x21
+	gifBlockImage           = 0x2C
+	gifBlockTrailer         = 0x3B
+	gifColorTableFlag       = 0x80
+	gifColorTableSizeMask   = 0x07
+)
+
+// gifPixels adds up the pixels of all frames of a GIF image, reading only the block headers. Decoding
+// allocates every frame, so the sum is what an animation costs in memory, unlike its logical screen size.
+// ok is false for data that is not a well-formed GIF.
+func gifPixels(data []byte) (pixels int, ok bool) {
+	offset := gifHeaderLength + gifScreenDescriptorSize
+	if offset > len(data) {
+		return 0, false
+	}
+	offset += colorTableSize(data[offset-3])
+
+	for offset < len(data) {
+		switch data[offset] {
+		case gifBlockTrailer:
+			return pixels, true
+		case gifBlockExtension:
+			// The introducer and the label are followed by data sub-blocks.
+			if offset = skipSubBlocks(data, offset+2); offset < 0 {
+				return 0, false
+			}
+		case gifBlockImage:
+			if offset+1+gifImageDescriptorSize > len(data) {
+				return 0, false
+			}
+			descriptor := data[offset+1:]
+			width := int(binary.LittleEndian.Uint16(descriptor[4:]))
+			height := int(binary.LittleEndian.Uint16(descriptor[6:]))
+			pixels += width * height
+
+			// The descriptor and local color table are followed by the LZW minimum code size and the image data.
+			offset += 1 + gifImageDescriptorSize + colorTableSize(descriptor[8]) + 1
+			if offset = skipSubBlocks(data, offset); offset < 0 {
+				return 0, false
+			}
+		default:
+			return 0, false
+		}
+	}
+
+	// The decoder rejects data cut off before the trailer too.
+	return 0, false
+}
+
+// colorTableSize is the length of the color table that the packed fields of a descriptor announce.
+func colorTableSize(packed byte) int {
+	if packed&gifColorTableFlag == 0 {
+		return 0
+	}
+	return 3 << (int(packed&gifColorTableSizeMask) + 1)
+}
+
+// skipSubBlocks returns the offset after the data sub-blocks starting at offset, each a length byte
+// and that many bytes, up to a zero length. It is -1 when the blocks run past the data.
+func skipSubBlocks(data []byte, offset int) int {
+	for offset < len(data) {
+		length := int(data[offset])
+		offset++
+		if length == 0 {
+			return offset
+		}
+		offset += length
+	}
+	return -1
+}
@@ -0,0 +1,205 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+)
+
+// Content types of the supported images.
+const (
+	JPEG = "image/jpeg"
+	PNG  = "image/png"
+	GIF  = "image/gif"
+)
+
+const (
+	jpegQuality      = 90
+	thumbnailQuality = 85
+)
+
+var (
+	ErrUnsupported = errors.New("unsupported image type")
+	ErrTooLarge    = errors.New("image is too large")
+)
+
+// Image is an image re-encoded from an upload. Re-encoding keeps only the pixels,
+// so EXIF, XMP, comments and any other metadata of the upload are dropped.
+type Image struct {
+	ContentType string
+	Width       int
+	Height      int
+	Data        []byte
+
+	decoded image.Image
+}
+
+// DetectType tells the type of an image by its magic bytes, ignoring file names and declared types.
+func DetectType(data []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8, 0xFF}):
+		return JPEG, nil
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return PNG, nil
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return GIF, nil
+	default:
+		return "", ErrUnsupported
+	}
+}
+
+// Extension is the file extension for images of the content type.
+func Extension(contentType string) string {
+	switch contentType {
+	case JPEG:
+		return ".jpg"
+	case GIF:
+		return ".gif"
+	default:
+		return ".png"
+	}
+}
+
+// Decode checks an upload and re-encodes it without metadata. Images with more than maxPixels pixels,
+// for animations in all frames together, are rejected before they are decoded, so a small file cannot
+// claim gigabytes of memory.
+// JPEG images are rotated as their EXIF orientation says, since the orientation is dropped with the rest of EXIF.
+func Decode(data []byte, maxPixels int) (*Image, error) {
+	contentType, err := DetectType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+	if contentType == GIF {
+		// Every frame of an animation is decoded, so a small logical screen can still hide thousands of frames.
+		pixels, ok := gifPixels(data)
+		if !ok {
+			return nil, ErrUnsupported
+		}
+		if pixels > maxPixels {
+			return nil, ErrTooLarge
+		}
+	}
+
+	var decoded image.Image
+	var buf bytes.Buffer
+	switch contentType {
+	case JPEG:
+		if decoded, err = jpeg.Decode(bytes.NewReader(data)); err != nil {
+			return nil, ErrUnsupported
+		}
+		decoded = orient(decoded, jpegOrientation(data))
+		err = jpeg.Encode(&buf, decoded, &jpeg.Options{Quality: jpegQuality})
+	case PNG:
+		if decoded, err = png.Decode(bytes.NewReader(data)); err != nil {
+			return nil, ErrUnsupported
+		}
+		err = png.Encode(&buf, decoded)
+	case GIF:
+		// All frames are kept, so animations stay animated; the first one is used for thumbnails.
+		var animation *gif.GIF
+		if animation, err = gif.DecodeAll(bytes.NewReader(data)); err != nil || len(animation.Image) == 0 {
+			return nil, ErrUnsupported
+		}
+		decoded = animation.Image[0]
+		err = gif.EncodeAll(&buf, animation)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := decoded.Bounds()
+	return &Image{
+		ContentType: contentType,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+		Data:        buf.Bytes(),
+		decoded:     decoded,
+	}, nil
+}
+
+// Thumbnail scales the image down to fit a size x size square, keeping its aspect ratio.
+// Smaller images keep their size. Thumbnails of JPEG images are JPEG, the rest are PNG to keep transparency.
+func (img *Image) Thumbnail(size int) (*Image, error) {
+	width, height := img.Width, img.Height
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/width)
+		} else {
+			width, height = max(1, width*size/height), size
+		}
+	}
+
+	scaled := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(scaled, scaled.Bounds(), img.decoded, img.decoded.Bounds(), draw.Src, nil)
+
+	thumbnail := &Image{Width: width, Height: height, decoded: scaled}
+
+	var buf bytes.Buffer
+	var err error
+	if img.ContentType == JPEG {
+		thumbnail.ContentType = JPEG
+		err = jpeg.Encode(&buf, scaled, &jpeg.Options{Quality: thumbnailQuality})
+	} else {
+		thumbnail.ContentType = PNG
+		err = png.Encode(&buf, scaled)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnail.Data = buf.Bytes()
+	return thumbnail, nil
+}
+
+// orient turns an image the way the EXIF orientation tag says it has to be shown: 2-8 mirror or rotate it, anything else keeps it.
+func orient(src image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if orientation >= 5 {
+		dst = image.NewRGBA(image.Rect(0, 0, height, width))
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored horizontally
+				dx, dy = width-1-x, y
+			case 3: // rotated 180
+				dx, dy = width-1-x, height-1-y
+			case 4: // mirrored vertically
+				dx, dy = x, height-1-y
+			case 5: // transposed
+				dx, dy = y, x
+			case 6: // rotated 90 clockwise
+				dx, dy = height-1-y, x
+			case 7: // transversed
+				dx, dy = height-1-y, width-1-x
+			case 8: // rotated 90 counterclockwise
+				dx, dy = y, width-1-x
+			}
+			dst.Set(dx, dy, src.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,237 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testImage is width x height, red in its top left quarter and white elsewhere.
+func testImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if x < width/4 && y < height/4 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.White)
+			}
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// withExif inserts an APP1 segment with the orientation tag and a camera model right after the start of image marker.
+func withExif(data []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 2)
+	tiff = append(tiff, 0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0x00, 0x00)
+	tiff = append(tiff, 0x01, 0x10, 0x00, 0x02, 0x00, 0x00, 0x00, 0x04, 'C', 'a', 'm', 0x00)
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x00)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := append([]byte{0xFF, 0xE1}, binary.BigEndian.AppendUint16(nil, uint16(len(segment)+2))...)
+	app1 = append(app1, segment...)
+
+	result := append([]byte{}, data[:2]...)
+	result = append(result, app1...)
+	return append(result, data[2:]...)
+}
+
+func TestDetectType(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		expected string
+		err      error
+	}{
+		{name: "JPEG", data: []byte{0xFF, 0xD8, 0xFF, 0xE0}, expected: JPEG},
+		{name: "PNG", data: []byte("\x89PNG\r\n\x1a\n...."), expected: PNG},
+		{name: "GIF87a", data: []byte("GIF87a...."), expected: GIF},
+		{name: "GIF89a", data: []byte("GIF89a...."), expected: GIF},
+		{name: "Text", data: []byte("<svg></svg>"), err: ErrUnsupported},
+		{name: "Empty", data: []byte{}, err: ErrUnsupported},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentType, err := DetectType(tt.data)
+
+			assert.Equal(t, tt.expected, contentType)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("StripsExif", func(t *testing.T) {
+		data := withExif(encodeJPEG(t, testImage(40, 20)), 1)
+		assert.Equal(t, 1, jpegOrientation(data))
+
+		img, err := Decode(data, 1_000_000)
+
+		require.NoError(t, err)
+		assert.Equal(t, JPEG, img.ContentType)
+		assert.Equal(t, 40, img.Width)
+		assert.Equal(t, 20, img.Height)
+		assert.NotContains(t, string(img.Data), "Exif")
+		assert.NotContains(t, string(img.Data), "Cam")
+	})
+
+	t.Run("AppliesOrientation", func(t *testing.T) {
+		data := withExif(encodeJPEG(t, testImage(40, 20)), 6)
+		assert.Equal(t, 6, jpegOrientation(data))
+
+		img, err := Decode(data, 1_000_000)
+
+		require.NoError(t, err)
+		assert.Equal(t, 20, img.Width)
+		assert.Equal(t, 40, img.Height)
+
+		decoded, err := jpeg.Decode(bytes.NewReader(img.Data))
+		require.NoError(t, err)
+		// Rotated clockwise, the red top left corner ends up in the top right one.
+		_, green, _, _ := decoded.At(18, 2).RGBA()
+		assert.Less(t, green>>8, uint32(100))
+		_, green, _, _ = decoded.At(2, 2).RGBA()
+		assert.Greater(t, green>>8, uint32(200))
+	})
+
+	t.Run("PNG", func(t *testing.T) {
+		img, err := Decode(encodePNG(t, testImage(10, 10)), 1_000_000)
+
+		require.NoError(t, err)
+		assert.Equal(t, PNG, img.ContentType)
+		assert.Equal(t, 10, img.Width)
+	})
+
+	t.Run("AnimatedGIF", func(t *testing.T) {
+		palette := color.Palette{color.White, color.Black}
+		animation := &gif.GIF{
+			Image: []*image.Paletted{
+				image.NewPaletted(image.Rect(0, 0, 8, 6), palette),
+				image.NewPaletted(image.Rect(0, 0, 8, 6), palette),
+			},
+			Delay: []int{10, 10},
+		}
+		var buf bytes.Buffer
+		require.NoError(t, gif.EncodeAll(&buf, animation))
+
+		img, err := Decode(buf.Bytes(), 1_000_000)
+
+		require.NoError(t, err)
+		assert.Equal(t, GIF, img.ContentType)
+
+		decoded, err := gif.DecodeAll(bytes.NewReader(img.Data))
+		require.NoError(t, err)
+		assert.Len(t, decoded.Image, 2)
+	})
+
+	t.Run("TooManyGIFFrames", func(t *testing.T) {
+		palette := color.Palette{color.White, color.Black}
+		animation := &gif.GIF{}
+		for range 1000 {
+			animation.Image = append(animation.Image, image.NewPaletted(image.Rect(0, 0, 100, 100), palette))
+			animation.Delay = append(animation.Delay, 1)
+		}
+		var buf bytes.Buffer
+		require.NoError(t, gif.EncodeAll(&buf, animation))
+
+		// The logical screen of 10 000 pixels fits, the 10 000 000 pixels of all frames do not.
+		assert.Less(t, buf.Len(), 1_000_000)
+		_, err := Decode(buf.Bytes(), 1_000_000)
+		assert.ErrorIs(t, err, ErrTooLarge)
+
+		pixels, ok := gifPixels(buf.Bytes())
+		assert.True(t, ok)
+		assert.Equal(t, 10_000_000, pixels)
+	})
+
+	t.Run("TruncatedGIF", func(t *testing.T) {
+		palette := color.Palette{color.White, color.Black}
+		animation := &gif.GIF{Image: []*image.Paletted{image.NewPaletted(image.Rect(0, 0, 8, 6), palette)}, Delay: []int{10}}
+		var buf bytes.Buffer
+		require.NoError(t, gif.EncodeAll(&buf, animation))
+
+		_, err := Decode(buf.Bytes()[:buf.Len()-3], 1_000_000)
+
+		assert.ErrorIs(t, err, ErrUnsupported)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		_, err := Decode(encodePNG(t, testImage(100, 100)), 9_999)
+
+		assert.ErrorIs(t, err, ErrTooLarge)
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		_, err := Decode(encodePNG(t, testImage(10, 10))[:40], 1_000_000)
+
+		assert.ErrorIs(t, err, ErrUnsupported)
+	})
+}
+
+func TestThumbnail(t *testing.T) {
+	tests := []struct {
+		name   string
+		width  int
+		height int
+		size   int
+		expect [2]int
+	}{
+		{name: "Landscape", width: 400, height: 200, size: 100, expect: [2]int{100, 50}},
+		{name: "Portrait", width: 200, height: 400, size: 100, expect: [2]int{50, 100}},
+		{name: "Small", width: 60, height: 30, size: 100, expect: [2]int{60, 30}},
+		{name: "Thin", width: 1000, height: 2, size: 100, expect: [2]int{100, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img, err := Decode(encodePNG(t, testImage(tt.width, tt.height)), 1_000_000)
+			require.NoError(t, err)
+
+			thumbnail, err := img.Thumbnail(tt.size)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, [2]int{thumbnail.Width, thumbnail.Height})
+
+			config, err := png.DecodeConfig(bytes.NewReader(thumbnail.Data))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, [2]int{config.Width, config.Height})
+		})
+	}
+
+	t.Run("JPEG", func(t *testing.T) {
+		img, err := Decode(encodeJPEG(t, testImage(400, 200)), 1_000_000)
+		require.NoError(t, err)
+
+		thumbnail, err := img.Thumbnail(100)
+
+		require.NoError(t, err)
+		assert.Equal(t, JPEG, thumbnail.ContentType)
+		_, err = jpeg.DecodeConfig(bytes.NewReader(thumbnail.Data))
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,90 @@
+package markup
+
+import (
+	"bytes"
+	"html"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	goldmarkHTML "github.com/yuin/goldmark/renderer/html"
+)
+
+// markdown renders CommonMark. Raw HTML is kept, as the output is sanitised anyway.
+var markdown = goldmark.New(goldmark.WithRendererOptions(goldmarkHTML.WithUnsafe()))
+
+// policy is the allow-list for user-generated content: text formatting, links, images, lists and tables.
+// Scripts, styles, event handlers and javascript: URLs are dropped.
+var policy = bluemonday.UGCPolicy()
+
+// Sanitize strips from HTML every element and attribute that is not allow-listed.
+func Sanitize(source string) string {
+	return policy.Sanitize(source)
+}
+
+// Markdown renders CommonMark as sanitised HTML.
+func Markdown(source string) (string, error) {
+	var buf bytes.Buffer
+	if err := markdown.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+
+	return Sanitize(buf.String()), nil
+}
+
+// Plain renders text as HTML paragraphs separated by blank lines, with line breaks kept.
+func Plain(source string) string {
+	source = strings.ReplaceAll(source, "\r\n", "\n")
+
+	var buf strings.Builder
+	for _, paragraph := range strings.Split(source, "\n\n") {
+		paragraph = strings.Trim(paragraph, "\n")
+		if paragraph == "" {
+			continue
+		}
+
+		buf.WriteString("<p>")
+		buf.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n"))
+		buf.WriteString("</p>\n")
+	}
+
+	return buf.String()
+}
+
+// HighlightStart and HighlightStop delimit matches in the search fragments Postgres returns. They are control
+// characters, so they do not clash with the text or its markup.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// Highlight renders a fragment of text with matches delimited by HighlightStart and HighlightStop as HTML:
+// the text is escaped and matches are wrapped in <b>. Delimiters out of place are dropped, so the tags stay balanced.
+func Highlight(fragment string) string {
+	var buf strings.Builder
+	open := false
+	for len(fragment) > 0 {
+		i := strings.IndexAny(fragment, HighlightStart+HighlightStop)
+		if i < 0 {
+			buf.WriteString(html.EscapeString(fragment))
+			break
+		}
+
+		buf.WriteString(html.EscapeString(fragment[:i]))
+		switch start := fragment[i:i+1] == HighlightStart; {
+		case start && !open:
+			buf.WriteString("<b>")
+			open = true
+		case !start && open:
+			buf.WriteString("</b>")
+			open = false
+		}
+		fragment = fragment[i+1:]
+	}
+
+	if open {
+		buf.WriteString("</b>")
+	}
+
+	return buf.String()
+}
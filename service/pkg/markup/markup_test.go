@@ -0,0 +1,83 @@
+package markup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{name: "KeepsFormatting", source: `<p><b>Bold</b> and <a href="https://example.com">link</a></p>`,
+			expected: `<p><b>Bold</b> and <a href="https://example.com" rel="nofollow">link</a></p>`},
+		{name: "DropsScript", source: `<p>Text</p><script>alert(1)</script>`, expected: `<p>Text</p>`},
+		{name: "DropsEventHandlers", source: `<img src="a.png" onerror="alert(1)">`, expected: `<img src="a.png">`},
+		{name: "DropsJavascriptURLs", source: `<a href="javascript:alert(1)">link</a>`, expected: `link`},
+		{name: "DropsStyles", source: `<p style="color:red">Text</p><style>p{}</style>`, expected: `<p>Text</p>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Sanitize(tt.source))
+		})
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{name: "Emphasis", source: "Some *emphasis* and **strong** text",
+			expected: "<p>Some <em>emphasis</em> and <strong>strong</strong> text</p>\n"},
+		{name: "HeadingAndList", source: "# Title\n\n- one\n- two",
+			expected: "<h1>Title</h1>\n<ul>\n<li>one</li>\n<li>two</li>\n</ul>\n"},
+		{name: "Link", source: "[site](https://example.com)",
+			expected: "<p><a href=\"https://example.com\" rel=\"nofollow\">site</a></p>\n"},
+		{name: "SanitisesRawHTML", source: "Text <script>alert(1)</script> <b>bold</b>",
+			expected: "<p>Text  <b>bold</b></p>\n"},
+		{name: "SanitisesJavascriptLinks", source: "[click](javascript:alert(1))",
+			expected: "<p>click</p>\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := Markdown(tt.source)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rendered)
+		})
+	}
+}
+
+func TestPlain(t *testing.T) {
+	assert.Equal(t, "<p>First &lt;b&gt; &amp; line<br>\nsecond line</p>\n<p>Next paragraph</p>\n",
+		Plain("First <b> & line\r\nsecond line\n\n\n\nNext paragraph\n"))
+	assert.Empty(t, Plain(""))
+}
+
+func TestHighlight(t *testing.T) {
+	testData := []struct {
+		name     string
+		fragment string
+		expected string
+	}{
+		{"matches", "Новости \x02спорта\x03 и \x02футбола\x03", "Новости <b>спорта</b> и <b>футбола</b>"},
+		{"html in content", "<script>alert(1)</script> \x02матч\x03 <img src=x onerror=alert(1)>",
+			"&lt;script&gt;alert(1)&lt;/script&gt; <b>матч</b> &lt;img src=x onerror=alert(1)&gt;"},
+		{"markup of matches", "<b>\x02goal\x03</b>", "&lt;b&gt;<b>goal</b>&lt;/b&gt;"},
+		{"unbalanced delimiters", "\x03a \x02b \x02c", "a <b>b c</b>"},
+		{"no matches", "a & b", "a &amp; b"},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Highlight(tt.fragment))
+		})
+	}
+}
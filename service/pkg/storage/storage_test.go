@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("PutGetDelete", func(t *testing.T) {
+		root := t.TempDir()
+		store, err := NewFileStore(root)
+		require.NoError(t, err)
+
+		require.NoError(t, store.Put(ctx, "media/1/original.jpg", strings.NewReader("first")))
+		require.NoError(t, store.Put(ctx, "media/1/original.jpg", strings.NewReader("second")))
+
+		reader, err := store.Get(ctx, "media/1/original.jpg")
+		require.NoError(t, err)
+		data, _ := io.ReadAll(reader)
+		reader.Close()
+		assert.Equal(t, "second", string(data))
+
+		// Only the blob is left in its directory, temporary files are removed.
+		entries, err := os.ReadDir(filepath.Join(root, "media", "1"))
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+
+		require.NoError(t, store.Delete(ctx, "media/1/original.jpg"))
+		_, err = store.Get(ctx, "media/1/original.jpg")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("DeleteMissing", func(t *testing.T) {
+		store, err := NewFileStore(t.TempDir())
+		require.NoError(t, err)
+
+		assert.NoError(t, store.Delete(ctx, "media/2/original.jpg"))
+	})
+
+	t.Run("InvalidKeys", func(t *testing.T) {
+		store, err := NewFileStore(t.TempDir())
+		require.NoError(t, err)
+
+		for _, key := range []string{"../outside", "/etc/passwd", "media/../../outside", ""} {
+			assert.Error(t, store.Put(ctx, key, strings.NewReader("data")), key)
+			_, err = store.Get(ctx, key)
+			assert.Error(t, err, key)
+		}
+	})
+}